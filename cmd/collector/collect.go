@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// 대상 하나의 레코드를 수집해서 대상의 출력 디렉토리에 저장합니다.
+// 마지막 전체 수집 후 reconcile이 지났으면 삭제된 레코드를 결과에서 빼기 위해 전체 수집합니다.
+func collect(ctx context.Context, f *fetcher, t target, full bool, reconcile time.Duration) error {
+	dir := filepath.Join(outputDir, t.Name)
+	outputPath := filepath.Join(dir, t.File)
+	partialPath := outputPath + ".partial"
+	statePath := filepath.Join(dir, stateFile)
+
+	// 출력 디렉토리 생성
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %w", err)
+	}
+
+	state, err := loadState(statePath)
+	if err != nil {
+		return fmt.Errorf("수집 상태 로드 실패: %w", err)
+	}
+
+	// 기존 수집 결과가 없거나 수집 조건이 바뀌었으면 워터마크가 있더라도 전체 수집한다.
+	since := state.Updated
+	if _, err := os.Stat(outputPath); full || err != nil || state.Query != t.Query {
+		since = ""
+	} else if since != "" && reconcileDue(state, reconcile, time.Now()) {
+		fmt.Printf("🧹 마지막 전체 수집 후 %s이 지나서 전체 %s를 다시 수집합니다\n", reconcile, f.src.label())
+		since = ""
+	}
+
+	query, err := f.src.buildQuery(t.Query, since)
+	if err != nil {
+		return fmt.Errorf("조회 조건 생성 실패: %w", err)
+	}
+
+	if since != "" {
+		fmt.Printf("🚀 %s 증분 수집 시작... (%s, 기준: %s)\n", f.src.label(), t.Name, since)
+	} else {
+		fmt.Printf("🚀 %s 수집 시작... (%s)\n", f.src.label(), t.Name)
+	}
+
+	checkpoint := resumeCheckpoint(state, query, partialPath)
+	if checkpoint.StartAt > 0 {
+		fmt.Printf("⏯️  중단된 수집을 %d번부터 이어서 진행합니다\n", checkpoint.StartAt)
+	}
+
+	writer, err := openIssueWriter(partialPath, checkpoint.Offset)
+	if err != nil {
+		return fmt.Errorf("수집 파일 준비 실패: %w", err)
+	}
+	defer func() { _ = writer.Close() }()
+
+	// 페이지는 동시에 조회하지만 handle은 페이지 순서대로 호출되므로 출력 순서가 항상 같다.
+	err = f.fetchPages(ctx, query, checkpoint.StartAt, func(startAt int, issues []json.RawMessage) error {
+		for _, issue := range issues {
+			if err := writer.Write(issue); err != nil {
+				return fmt.Errorf("이슈 기록 실패: %w", err)
+			}
+		}
+		offset, err := writer.Sync()
+		if err != nil {
+			return fmt.Errorf("이슈 기록 실패: %w", err)
+		}
+		fmt.Printf("📥 %d ~ %d번까지 수집 완료\n", startAt, startAt+len(issues))
+
+		if len(issues) < maxResults {
+			return nil
+		}
+
+		// 페이지 기록이 끝난 뒤에 체크포인트를 저장해야 재시작할 때 빠지는 이슈가 없다.
+		checkpoint.StartAt = startAt + maxResults
+		checkpoint.Offset = offset
+		state.Checkpoint = checkpoint
+		if err := saveState(statePath, state); err != nil {
+			return fmt.Errorf("체크포인트 저장 실패: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("페이지 조회 실패: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("수집 파일 닫기 실패: %w", err)
+	}
+
+	fetched, updated, err := latestUpdated(partialPath, since)
+	if err != nil {
+		return fmt.Errorf("워터마크 계산 실패: %w", err)
+	}
+
+	// 결과 저장
+	total := fetched
+	if since != "" {
+		total, err = mergeIssues(outputPath, partialPath)
+		if err != nil {
+			return fmt.Errorf("이슈 병합 실패: %w", err)
+		}
+		_ = os.Remove(partialPath)
+		fmt.Printf("🔄 변경된 %s %d개를 기존 결과에 병합했습니다\n", f.src.label(), fetched)
+	} else if err := os.Rename(partialPath, outputPath); err != nil {
+		return fmt.Errorf("파일 저장 실패: %w", err)
+	}
+
+	// 이슈 저장이 끝난 뒤에 워터마크를 갱신해야 중간에 실패해도 변경분을 놓치지 않는다.
+	fullCollected := state.FullCollected
+	if since == "" {
+		fullCollected = time.Now().Format(time.RFC3339)
+	}
+	if err := saveState(statePath, &State{Query: t.Query, Updated: updated, FullCollected: fullCollected}); err != nil {
+		return fmt.Errorf("수집 상태 저장 실패: %w", err)
+	}
+
+	fmt.Printf("\n✅ 총 %d개의 %s를 다음 경로에 저장했습니다:\n%s\n",
+		total, f.src.label(), outputPath)
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/devafterdark/project-lumos/pkg/confluence"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+const (
+	pagesFile = "pages.jsonl"
+	// Confluence 페이지 레코드의 출력 디렉토리 접두사. e.g., "confluence/DEV"
+	confluenceTargetPrefix = "confluence"
+	// CQL 날짜 형식. CQL은 분 단위까지만 비교할 수 있습니다.
+	cqlTimeFormat = "2006-01-02 15:04"
+	// 페이지 레코드의 날짜 형식. 이슈와 같은 형식으로 기록해야 병합과 워터마크 계산을 같이 쓸 수 있습니다.
+	recordTimeFormat = "2006-01-02T15:04:05.000Z0700"
+)
+
+type ConfluenceConfig struct {
+	Token   string
+	BaseURL string
+	// 수집할 스페이스 키 목록.
+	Spaces []string
+	// 사용자 지정 CQL. 스페이스 조건에 추가 조건으로 사용하므로 ORDER BY 절을 포함할 수 없습니다.
+	CQL string
+}
+
+// Confluence 설정 로드. 플래그 값이 있으면 환경변수보다 우선합니다.
+func loadConfluenceConfig(spaces, cql string) (*ConfluenceConfig, error) {
+	token := os.Getenv("CONFLUENCE_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("CONFLUENCE_TOKEN 환경변수가 설정되지 않았습니다")
+	}
+
+	baseURL := os.Getenv("CONFLUENCE_BASE_URL")
+	if baseURL == "" {
+		return nil, fmt.Errorf("CONFLUENCE_BASE_URL 환경변수가 설정되지 않았습니다")
+	}
+
+	if spaces == "" {
+		spaces = os.Getenv("CONFLUENCE_SPACES")
+	}
+	if spaces == "" {
+		return nil, fmt.Errorf("CONFLUENCE_SPACES 환경변수가 설정되지 않았습니다")
+	}
+	if cql == "" {
+		cql = os.Getenv("CONFLUENCE_CQL")
+	}
+
+	return &ConfluenceConfig{
+		Token:   token,
+		BaseURL: baseURL,
+		Spaces:  splitList(spaces),
+		CQL:     cql,
+	}, nil
+}
+
+// Confluence 검색 API에서 페이지를 수집합니다.
+// 페이지는 임베딩 명령이 읽을 수 있도록 이슈와 같은 문서 형식으로 변환해서 기록합니다.
+type confluenceSource struct {
+	client *confluence.Client
+	config *ConfluenceConfig
+}
+
+var _ source = (*confluenceSource)(nil)
+
+func newConfluenceSource(client *http.Client, config *ConfluenceConfig) *confluenceSource {
+	return &confluenceSource{
+		client: confluence.NewClient(client, config.BaseURL, config.Token),
+		config: config,
+	}
+}
+
+func (s *confluenceSource) label() string {
+	return "Confluence 페이지"
+}
+
+// 스페이스마다 대상을 만들고, 사용자 CQL은 추가 조건으로 사용합니다.
+func (s *confluenceSource) targets() []target {
+	targets := make([]target, 0, len(s.config.Spaces))
+	for _, space := range s.config.Spaces {
+		cql := fmt.Sprintf(`space = "%s" AND type = page`, space)
+		if s.config.CQL != "" {
+			cql = fmt.Sprintf("%s AND (%s)", cql, s.config.CQL)
+		}
+		targets = append(targets, target{
+			Name:  confluenceTargetPrefix + "/" + space,
+			File:  pagesFile,
+			Query: cql,
+		})
+	}
+	return targets
+}
+
+// 수집할 페이지를 조회하는 CQL 생성. since가 있으면 그 이후에 수정된 페이지만 조회합니다.
+// 페이지를 동시에 조회해도 결과가 겹치거나 빠지지 않도록 항상 생성일 순서로 정렬하고,
+// 생성일이 같은 페이지는 페이지 ID 순서로 정렬해서 순서가 항상 같도록 합니다.
+func (s *confluenceSource) buildQuery(cql, since string) (string, error) {
+	if since != "" {
+		t, err := jira.ParseTime(since)
+		if err != nil {
+			return "", fmt.Errorf("워터마크 파싱 실패: %w", err)
+		}
+		cql = fmt.Sprintf(`(%s) AND lastmodified >= "%s"`, cql, t.Format(cqlTimeFormat))
+	}
+
+	return cql + " ORDER BY created ASC, id ASC", nil
+}
+
+func (s *confluenceSource) fetch(ctx context.Context, cql string, startAt int) (*page, error) {
+	resp, err := s.client.Search(ctx, &confluence.SearchRequest{
+		CQL:   cql,
+		Start: startAt,
+		Limit: maxResults,
+	})
+	if err != nil {
+		var ce *confluence.Error
+		if errors.As(err, &ce) {
+			return nil, &statusError{code: ce.StatusCode, status: ce.Status, retryAfter: ce.RetryAfter}
+		}
+		return nil, err
+	}
+
+	// 검색 응답은 페이지 링크의 기본 URL을 응답 수준에만 포함한다.
+	baseURL := resp.Links.Base
+	if baseURL == "" {
+		baseURL = s.client.BaseURL
+	}
+
+	records := make([]json.RawMessage, 0, len(resp.Results))
+	for _, p := range resp.Results {
+		record, err := json.Marshal(document(&p, baseURL))
+		if err != nil {
+			return nil, fmt.Errorf("페이지 변환 실패: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return &page{Records: records, Total: resp.TotalSize}, nil
+}
+
+// 페이지 레코드. jira.Issue와 같은 필드 이름을 사용합니다.
+type pageDocument struct {
+	ID     string             `json:"id"`
+	Key    string             `json:"key"`
+	Source string             `json:"source"`
+	URL    string             `json:"url,omitempty"`
+	Fields pageDocumentFields `json:"fields"`
+}
+
+type pageDocumentFields struct {
+	Title   string    `json:"summary"`
+	Content string    `json:"description"`
+	Labels  []string  `json:"labels"`
+	Creator jira.User `json:"creator"`
+	Created string    `json:"created"`
+	Updated string    `json:"updated"`
+}
+
+func document(p *confluence.Page, baseURL string) *pageDocument {
+	if p.Links.Base != "" {
+		baseURL = p.Links.Base
+	}
+
+	var url string
+	if p.Links.WebUI != "" {
+		url = baseURL + p.Links.WebUI
+	}
+
+	return &pageDocument{
+		ID:     p.ID,
+		Key:    confluenceSourceName + ":" + p.ID,
+		Source: confluenceSourceName,
+		URL:    url,
+		Fields: pageDocumentFields{
+			Title:   p.Title,
+			Content: confluence.StorageToText(p.Body.Storage.Value),
+			Labels:  p.Labels(),
+			Creator: jira.User{
+				ID:           p.History.CreatedBy.Username,
+				Name:         p.History.CreatedBy.DisplayName,
+				EmailAddress: p.History.CreatedBy.Email,
+			},
+			Created: recordTime(p.History.CreatedDate),
+			Updated: recordTime(p.Version.When),
+		},
+	}
+}
+
+// Confluence 날짜를 레코드 날짜 형식으로 바꿉니다. 해석할 수 없으면 빈 문자열을 반환합니다.
+func recordTime(s string) string {
+	t, err := confluence.ParseTime(s)
+	if err != nil {
+		return ""
+	}
+	return t.Format(recordTimeFormat)
+}
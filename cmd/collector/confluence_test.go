@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestConfluenceBuildQuery(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cql      string
+		since    string
+		expected string
+	}{
+		{
+			desc:     "full collection",
+			cql:      `space = "DEV" AND type = page`,
+			expected: `space = "DEV" AND type = page ORDER BY created ASC, id ASC`,
+		},
+		{
+			desc:     "incremental collection",
+			cql:      `space = "DEV" AND type = page`,
+			since:    "2025-07-25T13:59:32.000+0900",
+			expected: `(space = "DEV" AND type = page) AND lastmodified >= "2025-07-25 13:59" ORDER BY created ASC, id ASC`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := &confluenceSource{config: &ConfluenceConfig{}}
+
+			got, err := s.buildQuery(tc.cql, tc.since)
+			if err != nil {
+				t.Fatalf("failed to build query: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/devafterdark/project-lumos/pkg/retry"
+)
+
+// 재시도할 수 없는 상태 코드를 구분하기 위한 HTTP 오류.
+type statusError struct {
+	code   int
+	status string
+	// Retry-After 헤더 값. 헤더가 없으면 0입니다.
+	retryAfter time.Duration
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("HTTP 오류: %s", e.status)
+}
+
+// 요청 제한(429)과 서버 오류(5xx)만 재시도합니다. 네트워크 오류도 재시도합니다.
+func retryable(err error) bool {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.code == http.StatusTooManyRequests || se.code >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// 페이지 조회 결과.
+type pageResult struct {
+	resp *page
+	err  error
+}
+
+// 수집 원본에서 레코드 페이지를 조회합니다.
+type fetcher struct {
+	src     source
+	limiter *rateLimiter
+	// 동시에 조회할 최대 페이지 수.
+	concurrency int
+	// 실패한 요청을 다시 시도하기 전 대기 시간. 재시도할 때마다 두 배로 늘어납니다.
+	// 429 응답에 Retry-After가 없으면 이 시간 동안 모든 요청을 멈춥니다.
+	backoff time.Duration
+}
+
+// startAt 위치부터 마지막 페이지까지 조회해서 페이지 순서대로 handle을 호출합니다.
+// 첫 페이지로 전체 레코드 수를 확인한 뒤, 나머지 페이지는 최대 concurrency개씩 동시에 조회합니다.
+// 조회가 끝났지만 아직 처리하지 못한 페이지도 concurrency개를 넘지 않습니다.
+func (f *fetcher) fetchPages(
+	ctx context.Context,
+	query string,
+	startAt int,
+	handle func(startAt int, records []json.RawMessage) error,
+) error {
+	first, err := f.fetchPage(ctx, query, startAt)
+	if err != nil {
+		return err
+	}
+	if err := handle(startAt, first.Records); err != nil {
+		return err
+	}
+	if len(first.Records) < maxResults {
+		return nil
+	}
+
+	var starts []int
+	for s := startAt + maxResults; s < first.Total; s += maxResults {
+		starts = append(starts, s)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]chan pageResult, len(starts))
+	for i := range results {
+		results[i] = make(chan pageResult, 1)
+	}
+
+	window := make(chan struct{}, f.concurrency)
+	go func() {
+		for i, s := range starts {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func() {
+				resp, err := f.fetchPage(ctx, query, s)
+				results[i] <- pageResult{resp: resp, err: err}
+			}()
+		}
+	}()
+
+	last := len(first.Records)
+	for i, s := range starts {
+		var r pageResult
+		select {
+		case r = <-results[i]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		<-window
+
+		if r.err != nil {
+			return r.err
+		}
+		if err := handle(s, r.resp.Records); err != nil {
+			return err
+		}
+		last = len(r.resp.Records)
+	}
+
+	// 조회하는 동안 레코드가 추가되었거나 전체 수를 알 수 없으면 마지막 페이지가 가득 차 있으면 이어서 조회한다.
+	if last == maxResults {
+		return f.fetchPages(ctx, query, startAt+maxResults*(len(starts)+1), handle)
+	}
+
+	return nil
+}
+
+// 페이지 하나를 조회합니다. 실패하면 재시도하고, 429 응답을 받으면 Retry-After 동안 모든 요청을 멈춥니다.
+func (f *fetcher) fetchPage(ctx context.Context, query string, startAt int) (*page, error) {
+	attempt := 0
+	return retry.DoWithData(ctx, func(ctx context.Context) (*page, error) {
+		attempt++
+		if err := f.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := f.src.fetch(ctx, query, startAt)
+		if err != nil {
+			var se *statusError
+			if errors.As(err, &se) && se.code == http.StatusTooManyRequests {
+				// Retry-After가 없으면 재시도 대기 시간 동안 멈춘다.
+				wait := se.retryAfter
+				if wait == 0 {
+					wait = f.backoff
+				}
+				f.limiter.Pause(wait)
+			}
+			if ctx.Err() == nil {
+				fmt.Printf("⚠️  %d~ 요청 실패 (시도 %d/%d): %v\n", startAt, attempt, maxRetries+1, err)
+			}
+			return nil, err
+		}
+		return resp, nil
+	},
+		retry.WithMaxRetries(maxRetries),
+		retry.WithBackoff(f.backoff),
+		retry.WithRetryable(func(err error) bool {
+			return ctx.Err() == nil && retryable(err)
+		}),
+	)
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startAt부터 한 페이지의 이슈를 반환하는 Jira 검색 API.
+// 앞 페이지일수록 늦게 응답해서 동시에 조회한 페이지가 순서와 다르게 완료되도록 합니다.
+type fakeSearch struct {
+	total int
+	// 요청마다 호출해서 0이 아닌 상태 코드를 반환하면 그 상태로 응답합니다.
+	fail func(startAt int) (int, http.Header)
+
+	mu       sync.Mutex
+	requests []int
+	// 동시에 처리 중인 요청 수의 최댓값.
+	inflight, maxInflight atomic.Int32
+}
+
+func (s *fakeSearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := s.inflight.Add(1)
+	defer s.inflight.Add(-1)
+	for {
+		m := s.maxInflight.Load()
+		if n <= m || s.maxInflight.CompareAndSwap(m, n) {
+			break
+		}
+	}
+
+	startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+	s.mu.Lock()
+	s.requests = append(s.requests, startAt)
+	s.mu.Unlock()
+
+	if s.fail != nil {
+		if code, header := s.fail(startAt); code != 0 {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(code)
+			return
+		}
+	}
+
+	time.Sleep(time.Duration(max(s.total-startAt, 0)/maxResults) * 5 * time.Millisecond)
+
+	var issues []string
+	for i := startAt; i < min(startAt+maxResults, s.total); i++ {
+		issues = append(issues, fmt.Sprintf(`{"key":"AA-%d"}`, i))
+	}
+	_, _ = fmt.Fprintf(w, `{"total":%d,"issues":[%s]}`, s.total, strings.Join(issues, ","))
+}
+
+func newTestFetcher(t *testing.T, search *fakeSearch, rate float64, concurrency int) *fetcher {
+	t.Helper()
+
+	server := httptest.NewServer(search)
+	t.Cleanup(server.Close)
+
+	return &fetcher{
+		src: &jiraSource{
+			client: server.Client(),
+			config: &JiraConfig{Token: "token", BaseURL: server.URL + "/rest/api/2/search"},
+		},
+		limiter:     newRateLimiter(rate, concurrency),
+		concurrency: concurrency,
+		backoff:     10 * time.Millisecond,
+	}
+}
+
+// fetchPages로 받은 페이지 시작 위치와 이슈 키를 순서대로 모읍니다.
+func collectPages(ctx context.Context, f *fetcher, startAt int) ([]int, []string, error) {
+	var (
+		starts []int
+		keys   []string
+	)
+	err := f.fetchPages(ctx, "project=AA", startAt, func(startAt int, records []json.RawMessage) error {
+		starts = append(starts, startAt)
+		for _, r := range records {
+			var h issueHeader
+			if err := json.Unmarshal(r, &h); err != nil {
+				return err
+			}
+			keys = append(keys, h.Key)
+		}
+		return nil
+	})
+	return starts, keys, err
+}
+
+func TestFetchPages(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		total        int
+		startAt      int
+		concurrency  int
+		expectStarts []int
+	}{
+		{
+			desc:         "single page",
+			total:        30,
+			concurrency:  4,
+			expectStarts: []int{0},
+		},
+		{
+			desc:         "pages in order",
+			total:        750,
+			concurrency:  4,
+			expectStarts: []int{0, 100, 200, 300, 400, 500, 600, 700},
+		},
+		{
+			// 마지막 페이지가 가득 차 있으면 레코드가 추가되었을 수 있으므로 빈 페이지가 나올 때까지 조회한다.
+			desc:         "exact last page",
+			total:        300,
+			concurrency:  2,
+			expectStarts: []int{0, 100, 200, 300},
+		},
+		{
+			desc:         "resume from checkpoint",
+			total:        450,
+			startAt:      200,
+			concurrency:  4,
+			expectStarts: []int{200, 300, 400},
+		},
+		{
+			desc:         "sequential",
+			total:        350,
+			concurrency:  1,
+			expectStarts: []int{0, 100, 200, 300},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			search := &fakeSearch{total: tc.total}
+			f := newTestFetcher(t, search, 1000, tc.concurrency)
+
+			starts, keys, err := collectPages(context.Background(), f, tc.startAt)
+			if err != nil {
+				t.Fatalf("failed to fetch pages: %v", err)
+			}
+
+			if fmt.Sprint(starts) != fmt.Sprint(tc.expectStarts) {
+				t.Errorf("expected pages %v, got %v", tc.expectStarts, starts)
+			}
+			if len(keys) != tc.total-tc.startAt {
+				t.Fatalf("expected %d issues, got %d", tc.total-tc.startAt, len(keys))
+			}
+			for i, key := range keys {
+				if expected := fmt.Sprintf("AA-%d", tc.startAt+i); key != expected {
+					t.Fatalf("expected %s at %d, got %s", expected, i, key)
+				}
+			}
+			if got := int(search.maxInflight.Load()); got > tc.concurrency {
+				t.Errorf("expected at most %d concurrent requests, got %d", tc.concurrency, got)
+			}
+		})
+	}
+}
+
+func TestFetchPagesRetryAfter(t *testing.T) {
+	var failed atomic.Bool
+	search := &fakeSearch{
+		total: 250,
+		fail: func(startAt int) (int, http.Header) {
+			if startAt == 100 && failed.CompareAndSwap(false, true) {
+				return http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}}
+			}
+			return 0, nil
+		},
+	}
+	f := newTestFetcher(t, search, 1000, 2)
+
+	begin := time.Now()
+	starts, keys, err := collectPages(context.Background(), f, 0)
+	if err != nil {
+		t.Fatalf("failed to fetch pages: %v", err)
+	}
+
+	if fmt.Sprint(starts) != "[0 100 200]" {
+		t.Errorf("expected pages [0 100 200], got %v", starts)
+	}
+	if len(keys) != 250 {
+		t.Errorf("expected 250 issues, got %d", len(keys))
+	}
+	// 429 응답을 받으면 Retry-After 동안 모든 요청을 멈춘 뒤 다시 시도한다.
+	if elapsed := time.Since(begin); elapsed < time.Second {
+		t.Errorf("expected to pause for Retry-After, finished in %v", elapsed)
+	}
+	retried := 0
+	for _, s := range search.requests {
+		if s == 100 {
+			retried++
+		}
+	}
+	if retried != 2 {
+		t.Errorf("expected page 100 to be requested twice, got %d", retried)
+	}
+}
+
+func TestFetchPagesError(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		code          int
+		expectRetries int
+	}{
+		{
+			desc:          "not retryable",
+			code:          http.StatusBadRequest,
+			expectRetries: 1,
+		},
+		{
+			desc:          "server error",
+			code:          http.StatusServiceUnavailable,
+			expectRetries: maxRetries + 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			search := &fakeSearch{
+				total: 300,
+				fail: func(startAt int) (int, http.Header) {
+					if startAt == 200 {
+						return tc.code, nil
+					}
+					return 0, nil
+				},
+			}
+			f := newTestFetcher(t, search, 1000, 2)
+
+			starts, _, err := collectPages(context.Background(), f, 0)
+			var se *statusError
+			if !errors.As(err, &se) || se.code != tc.code {
+				t.Fatalf("expected status %d, got %v", tc.code, err)
+			}
+			// 실패한 페이지 앞의 페이지는 모두 처리된다.
+			if fmt.Sprint(starts) != "[0 100]" {
+				t.Errorf("expected pages [0 100], got %v", starts)
+			}
+			requested := 0
+			for _, s := range search.requests {
+				if s == 200 {
+					requested++
+				}
+			}
+			if requested != tc.expectRetries {
+				t.Errorf("expected %d requests, got %d", tc.expectRetries, requested)
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		rate      float64
+		burst     int
+		requests  int
+		pause     time.Duration
+		expectMin time.Duration
+		expectMax time.Duration
+	}{
+		{
+			desc:      "burst",
+			rate:      10,
+			burst:     5,
+			requests:  5,
+			expectMin: 0,
+			expectMax: 50 * time.Millisecond,
+		},
+		{
+			desc:      "pacing after burst",
+			rate:      20,
+			burst:     1,
+			requests:  5,
+			expectMin: 190 * time.Millisecond,
+			expectMax: 400 * time.Millisecond,
+		},
+		{
+			desc:      "pause",
+			rate:      1000,
+			burst:     5,
+			requests:  1,
+			pause:     100 * time.Millisecond,
+			expectMin: 100 * time.Millisecond,
+			expectMax: 300 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			l := newRateLimiter(tc.rate, tc.burst)
+			if tc.pause > 0 {
+				l.Pause(tc.pause)
+			}
+
+			begin := time.Now()
+			for range tc.requests {
+				if err := l.Wait(context.Background()); err != nil {
+					t.Fatalf("failed to wait: %v", err)
+				}
+			}
+
+			elapsed := time.Since(begin)
+			if elapsed < tc.expectMin || elapsed > tc.expectMax {
+				t.Errorf("expected %v ~ %v, got %v", tc.expectMin, tc.expectMax, elapsed)
+			}
+		})
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	l.Pause(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devafterdark/project-lumos/pkg/jira"
+	"github.com/devafterdark/project-lumos/pkg/retry"
+)
+
+const (
+	issuesFile = "issues.jsonl"
+	// 프로젝트 없이 JQL만 지정했을 때 사용하는 출력 디렉토리 이름.
+	queryTargetName = "query"
+	// JQL 날짜 형식. JQL은 분 단위까지만 비교할 수 있습니다.
+	jqlTimeFormat = "2006-01-02 15:04"
+	// Jira 사용자의 시간대를 알 수 없을 때 워터마크를 앞당기는 시간. 시간대 차이는 하루를 넘지 않습니다.
+	watermarkMargin = 24 * time.Hour
+)
+
+// 기본으로 수집할 이슈 필드 목록.
+// created, updated, creator, assignee는 jira.Issue와 증분 수집의 워터마크 계산에 필요합니다.
+var defaultFields = []string{
+	"summary",
+	"description",
+	"labels",
+	"status",
+	"comment",
+	"components",
+	"fixVersions",
+	"issuelinks",
+	"priority",
+	"issuetype",
+	"resolution",
+	"resolutiondate",
+	"parent",
+	"subtasks",
+	"attachment",
+	"created",
+	"updated",
+	"creator",
+	"assignee",
+}
+
+type JiraResponse struct {
+	Issues []json.RawMessage `json:"issues"`
+	Total  int               `json:"total"`
+}
+
+type JiraConfig struct {
+	Token   string
+	BaseURL string
+	// 수집할 프로젝트 목록.
+	Projects []string
+	// 사용자 지정 JQL. 프로젝트가 지정되면 추가 조건으로 사용하므로 ORDER BY 절을 포함할 수 없습니다.
+	JQL string
+	// 조회할 이슈 필드 목록.
+	Fields []string
+}
+
+// Jira 설정 로드. 플래그 값이 있으면 환경변수보다 우선합니다.
+func loadJiraConfig(projects, jql, fields string) (*JiraConfig, error) {
+	token := os.Getenv("JIRA_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("JIRA_TOKEN 환경변수가 설정되지 않았습니다")
+	}
+
+	baseURL := os.Getenv("JIRA_BASE_URL")
+	if baseURL == "" {
+		return nil, fmt.Errorf("JIRA_BASE_URL 환경변수가 설정되지 않았습니다")
+	}
+
+	if projects == "" {
+		projects = os.Getenv("JIRA_PROJECT")
+	}
+	if jql == "" {
+		jql = os.Getenv("JIRA_JQL")
+	}
+	if projects == "" && jql == "" {
+		return nil, fmt.Errorf("JIRA_PROJECT 또는 JIRA_JQL 환경변수가 설정되지 않았습니다")
+	}
+
+	if fields == "" {
+		fields = os.Getenv("JIRA_FIELDS")
+	}
+	fieldList := defaultFields
+	if fields != "" {
+		fieldList = splitList(fields)
+	}
+	// 증분 수집의 워터마크를 계산하려면 수정일이 필요하다.
+	if !slices.Contains(fieldList, "updated") && !slices.Contains(fieldList, "*all") {
+		fieldList = append(fieldList, "updated")
+	}
+
+	return &JiraConfig{
+		Token:    token,
+		BaseURL:  baseURL,
+		Projects: splitList(projects),
+		JQL:      jql,
+		Fields:   fieldList,
+	}, nil
+}
+
+// Jira 검색 API에서 이슈를 수집합니다.
+type jiraSource struct {
+	client *http.Client
+	config *JiraConfig
+	// API 사용자의 시간대. JQL의 날짜는 이 시간대로 해석됩니다. nil이면 알 수 없습니다.
+	location *time.Location
+}
+
+var _ source = (*jiraSource)(nil)
+
+func (s *jiraSource) label() string {
+	return "JIRA 이슈"
+}
+
+// 프로젝트가 지정되면 프로젝트마다 대상을 만들고, 사용자 JQL은 추가 조건으로 사용합니다.
+// 프로젝트 없이 JQL만 지정되면 JQL 결과 전체를 하나의 대상으로 수집합니다.
+func (s *jiraSource) targets() []target {
+	c := s.config
+	if len(c.Projects) == 0 {
+		return []target{{Name: queryTargetName, File: issuesFile, Query: c.JQL}}
+	}
+
+	targets := make([]target, 0, len(c.Projects))
+	for _, project := range c.Projects {
+		jql := fmt.Sprintf("project=%s", project)
+		if c.JQL != "" {
+			jql = fmt.Sprintf("%s AND (%s)", jql, c.JQL)
+		}
+		targets = append(targets, target{Name: project, File: issuesFile, Query: jql})
+	}
+	return targets
+}
+
+// API 사용자의 시간대를 조회합니다. (GET /rest/api/2/myself)
+// JQL의 날짜는 사용자 시간대로 해석되므로, 워터마크를 이 시간대로 바꿔야 변경된 이슈를 놓치지 않습니다.
+func (s *jiraSource) loadTimeZone(ctx context.Context) error {
+	u, err := url.Parse(s.config.BaseURL)
+	if err != nil {
+		return err
+	}
+	// 검색 API 주소(/rest/api/2/search)와 같은 버전의 사용자 API를 사용한다.
+	u.Path = path.Join(path.Dir(u.Path), "myself")
+	u.RawQuery = ""
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.config.Token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP 오류: %s", resp.Status)
+	}
+
+	var user struct {
+		TimeZone string `json:"timeZone"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return fmt.Errorf("JSON 파싱 실패: %w", err)
+	}
+
+	if user.TimeZone == "" {
+		return errors.New("사용자 시간대가 없습니다")
+	}
+	loc, err := time.LoadLocation(user.TimeZone)
+	if err != nil {
+		return fmt.Errorf("알 수 없는 시간대입니다: %w", err)
+	}
+	s.location = loc
+	return nil
+}
+
+// 수집할 이슈를 조회하는 JQL 생성. since가 있으면 그 이후에 수정된 이슈만 조회합니다.
+// 페이지를 동시에 조회해도 결과가 겹치거나 빠지지 않도록 항상 이슈 키 순서로 정렬합니다.
+func (s *jiraSource) buildQuery(jql, since string) (string, error) {
+	if since != "" {
+		t, err := jira.ParseTime(since)
+		if err != nil {
+			return "", fmt.Errorf("워터마크 파싱 실패: %w", err)
+		}
+
+		// JQL의 날짜는 API 사용자의 시간대로 해석되므로 워터마크를 그 시간대로 바꾼다.
+		// 시간대를 모르면 시간대 차이만큼 놓치지 않도록 워터마크를 앞당긴다.
+		if s.location != nil {
+			t = t.In(s.location)
+		} else {
+			t = t.Add(-watermarkMargin)
+		}
+
+		// JQL 비교는 분 단위이므로 같은 분에 수정된 이슈가 다시 조회될 수 있지만, 키 기준으로 병합하기 때문에 문제되지 않는다.
+		updated := fmt.Sprintf(`updated >= "%s"`, t.Format(jqlTimeFormat))
+		if jql == "" {
+			jql = updated
+		} else {
+			jql = fmt.Sprintf("(%s) AND %s", jql, updated)
+		}
+	}
+
+	return jql + " ORDER BY key ASC", nil
+}
+
+func (s *jiraSource) fetch(ctx context.Context, jql string, startAt int) (*page, error) {
+	req, err := createRequest(ctx, s.config, jql, startAt)
+	if err != nil {
+		return nil, fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{
+			code:       resp.StatusCode,
+			status:     resp.Status,
+			retryAfter: retry.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("응답 읽기 실패: %w", err)
+	}
+
+	var jiraResp JiraResponse
+	if err := json.Unmarshal(body, &jiraResp); err != nil {
+		return nil, fmt.Errorf("JSON 파싱 실패: %w", err)
+	}
+
+	return &page{Records: jiraResp.Issues, Total: jiraResp.Total}, nil
+}
+
+func createRequest(ctx context.Context, config *JiraConfig, jql string, startAt int) (*http.Request, error) {
+	u, err := url.Parse(config.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("jql", jql)
+	q.Set("startAt", strconv.Itoa(startAt))
+	q.Set("maxResults", strconv.Itoa(maxResults))
+	q.Set("fields", strings.Join(config.Fields, ","))
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+config.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestJiraBuildQuery(t *testing.T) {
+	seoul := time.FixedZone("KST", 9*60*60)
+	newYork := time.FixedZone("EST", -5*60*60)
+
+	testCases := []struct {
+		desc     string
+		jql      string
+		since    string
+		location *time.Location
+		expected string
+	}{
+		{
+			desc:     "full collection",
+			jql:      "project=AA",
+			expected: "project=AA ORDER BY key ASC",
+		},
+		{
+			desc:     "same time zone",
+			jql:      "project=AA",
+			since:    "2025-07-25T13:59:32.000+0900",
+			location: seoul,
+			expected: `(project=AA) AND updated >= "2025-07-25 13:59" ORDER BY key ASC`,
+		},
+		{
+			desc:     "convert to user time zone",
+			jql:      "project=AA",
+			since:    "2025-07-25T13:59:32.000+0900",
+			location: newYork,
+			expected: `(project=AA) AND updated >= "2025-07-24 23:59" ORDER BY key ASC`,
+		},
+		{
+			desc:     "unknown time zone",
+			since:    "2025-07-25T13:59:32.000+0900",
+			expected: `updated >= "2025-07-24 13:59" ORDER BY key ASC`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := &jiraSource{config: &JiraConfig{}, location: tc.location}
+
+			got, err := s.buildQuery(tc.jql, tc.since)
+			if err != nil {
+				t.Fatalf("failed to build query: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestJiraLoadTimeZone(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		status         int
+		body           string
+		expectErr      bool
+		expectLocation string
+	}{
+		{
+			desc:           "user time zone",
+			status:         http.StatusOK,
+			body:           `{"name":"user","timeZone":"America/New_York"}`,
+			expectLocation: "America/New_York",
+		},
+		{
+			desc:      "missing time zone",
+			status:    http.StatusOK,
+			body:      `{"name":"user"}`,
+			expectErr: true,
+		},
+		{
+			desc:      "unknown time zone",
+			status:    http.StatusOK,
+			body:      `{"timeZone":"Mars/Olympus"}`,
+			expectErr: true,
+		},
+		{
+			desc:      "unauthorized",
+			status:    http.StatusUnauthorized,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest/api/2/myself" {
+					t.Errorf("expected path /rest/api/2/myself, got %s", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer token" {
+					t.Errorf("expected bearer token, got %q", got)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			s := &jiraSource{
+				client: server.Client(),
+				config: &JiraConfig{Token: "token", BaseURL: server.URL + "/rest/api/2/search"},
+			}
+			err := s.loadTimeZone(context.Background())
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if s.location != nil {
+					t.Errorf("expected no location, got %v", s.location)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load time zone: %v", err)
+			}
+			if s.location.String() != tc.expectLocation {
+				t.Errorf("expected location %s, got %s", tc.expectLocation, s.location)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// 병합과 워터마크 계산에 필요한 최소한의 이슈 정보.
+type issueHeader struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Updated string `json:"updated"`
+	} `json:"fields"`
+}
+
+// 이슈를 JSON Lines 형식으로 파일에 기록합니다.
+type issueWriter struct {
+	file *os.File
+	buf  bytes.Buffer
+}
+
+// offset 위치부터 이어서 기록하도록 파일을 엽니다.
+// offset 이후에 기록된 내용은 체크포인트에 반영되지 않은 것이므로 잘라냅니다.
+func openIssueWriter(path string, offset int64) (*issueWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("파일 열기 실패: %w", err)
+	}
+
+	if err := file.Truncate(offset); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("파일 자르기 실패: %w", err)
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("파일 위치 이동 실패: %w", err)
+	}
+
+	return &issueWriter{file: file}, nil
+}
+
+// 이슈 하나를 한 줄로 기록합니다.
+func (w *issueWriter) Write(issue json.RawMessage) error {
+	w.buf.Reset()
+	if err := json.Compact(&w.buf, issue); err != nil {
+		return fmt.Errorf("JSON 압축 실패: %w", err)
+	}
+	w.buf.WriteByte('\n')
+
+	if _, err := w.file.Write(w.buf.Bytes()); err != nil {
+		return fmt.Errorf("파일 쓰기 실패: %w", err)
+	}
+
+	return nil
+}
+
+// 기록한 내용을 디스크에 반영하고 현재까지 기록한 바이트 수를 반환합니다.
+func (w *issueWriter) Sync() (int64, error) {
+	if err := w.file.Sync(); err != nil {
+		return 0, fmt.Errorf("파일 동기화 실패: %w", err)
+	}
+	return w.file.Seek(0, io.SeekCurrent)
+}
+
+func (w *issueWriter) Close() error {
+	return w.file.Close()
+}
+
+// JSON Lines 파일의 이슈를 순서대로 읽어 fn을 호출합니다.
+func readIssues(path string, fn func(issue json.RawMessage) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	for {
+		var issue json.RawMessage
+		if err := decoder.Decode(&issue); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("이슈 파싱 실패: %w", err)
+		}
+
+		if err := fn(issue); err != nil {
+			return err
+		}
+	}
+}
+
+// 같은 디렉토리의 임시 파일에 기록한 뒤 이름을 바꿔서, 중간에 실패해도 기존 파일이 손상되지 않도록 저장합니다.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	// 이름을 바꾼 뒤에는 임시 파일이 없으므로 삭제 실패는 무시한다.
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	// CreateTemp는 0600 권한으로 파일을 만들기 때문에 다른 출력 파일과 권한을 맞춘다.
+	if err := tmp.Chmod(0644); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("임시 파일 권한 변경 실패: %w", err)
+	}
+
+	w := bufio.NewWriter(tmp)
+	if err := write(w); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("임시 파일 쓰기 실패: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("임시 파일 동기화 실패: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("임시 파일 닫기 실패: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("파일 이름 변경 실패: %w", err)
+	}
+
+	return nil
+}
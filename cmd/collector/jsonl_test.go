@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestOpenIssueWriter(t *testing.T) {
+	written := "{\"key\":\"AA-1\"}\n{\"key\":\"AA-2\"}\n"
+
+	testCases := []struct {
+		desc     string
+		existing string
+		offset   int64
+		expected []string
+	}{
+		{
+			desc:     "new file",
+			offset:   0,
+			expected: []string{`{"key":"AA-3"}`},
+		},
+		{
+			desc:     "truncate without checkpoint",
+			existing: written,
+			offset:   0,
+			expected: []string{`{"key":"AA-3"}`},
+		},
+		{
+			desc:     "resume after checkpoint",
+			existing: written,
+			offset:   int64(len(written)),
+			expected: []string{`{"key":"AA-1"}`, `{"key":"AA-2"}`, `{"key":"AA-3"}`},
+		},
+		{
+			desc:     "drop trailing incomplete line",
+			existing: written + "{\"key\":\"AA-",
+			offset:   int64(len(written)),
+			expected: []string{`{"key":"AA-1"}`, `{"key":"AA-2"}`, `{"key":"AA-3"}`},
+		},
+		{
+			desc:     "drop lines written after checkpoint",
+			existing: written,
+			offset:   int64(len("{\"key\":\"AA-1\"}\n")),
+			expected: []string{`{"key":"AA-1"}`, `{"key":"AA-3"}`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "issues.jsonl.partial")
+			if tc.existing != "" {
+				if err := os.WriteFile(path, []byte(tc.existing), 0644); err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			w, err := openIssueWriter(path, tc.offset)
+			if err != nil {
+				t.Fatalf("failed to open writer: %v", err)
+			}
+			if err := w.Write(json.RawMessage("{\n  \"key\": \"AA-3\"\n}")); err != nil {
+				t.Fatalf("failed to write issue: %v", err)
+			}
+			offset, err := w.Sync()
+			if err != nil {
+				t.Fatalf("failed to sync: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("failed to close: %v", err)
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatalf("failed to stat file: %v", err)
+			}
+			if offset != info.Size() {
+				t.Errorf("expected offset %d, got %d", info.Size(), offset)
+			}
+			if got := readLines(t, path); !slices.Equal(got, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		existing string
+		writeErr error
+		expected string
+	}{
+		{
+			desc:     "create file",
+			expected: "new",
+		},
+		{
+			desc:     "replace file",
+			existing: "old",
+			expected: "new",
+		},
+		{
+			desc:     "keep file on failure",
+			existing: "old",
+			writeErr: errors.New("write failed"),
+			expected: "old",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "state.json")
+			if tc.existing != "" {
+				if err := os.WriteFile(path, []byte(tc.existing), 0644); err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			err := writeFileAtomic(path, func(w io.Writer) error {
+				if _, err := w.Write([]byte("new")); err != nil {
+					return err
+				}
+				return tc.writeErr
+			})
+			if !errors.Is(err, tc.writeErr) {
+				t.Fatalf("expected %v, got %v", tc.writeErr, err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+			if string(data) != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, data)
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatalf("failed to stat file: %v", err)
+			}
+			if info.Mode().Perm() != 0644 {
+				t.Errorf("expected mode 0644, got %v", info.Mode().Perm())
+			}
+
+			// 임시 파일은 남지 않는다.
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatalf("failed to read dir: %v", err)
+			}
+			if len(entries) != 1 {
+				t.Errorf("expected only the target file, got %d entries", len(entries))
+			}
+		})
+	}
+}
@@ -1,14 +1,14 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 	"os"
-	"path/filepath"
-	"strconv"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 )
 
@@ -16,192 +16,103 @@ const (
 	maxResults = 100
 	timeout    = 300 * time.Second
 	maxRetries = 3
-	outputDir  = "output"
-	outputFile = "gs_issues.json"
+	// 실패한 요청을 다시 시도하기 전 대기 시간. 재시도할 때마다 두 배로 늘어납니다.
+	retryBackoff = 3 * time.Second
+	outputDir    = "output"
+	stateFile    = "state.json"
 )
 
-type JiraResponse struct {
-	Issues []map[string]any `json:"issues"`
-	Total  int              `json:"total"`
-}
-
-type Config struct {
-	Token   string
-	BaseURL string
-	Project string
-}
+// 수집 원본 이름.
+const (
+	jiraSourceName       = "jira"
+	confluenceSourceName = "confluence"
+)
 
 func main() {
-	// 설정 로드
-	config, err := loadConfig()
-	if err != nil {
-		fmt.Printf("❌ 설정 로드 실패: %v\n", err)
+	full := flag.Bool("full", false, "기존 수집 결과를 무시하고 전체를 다시 수집")
+	sourceName := flag.String("source", jiraSourceName, "수집 원본 (jira, confluence)")
+	projects := flag.String("projects", "", "쉼표로 구분된 수집 대상 프로젝트 목록 (기본값: JIRA_PROJECT 환경변수)")
+	jql := flag.String("jql", "", "수집할 이슈를 조회하는 JQL (기본값: JIRA_JQL 환경변수)")
+	fields := flag.String("fields", "", "쉼표로 구분된 조회 필드 목록 (기본값: JIRA_FIELDS 환경변수)")
+	spaces := flag.String("spaces", "", "쉼표로 구분된 수집 대상 Confluence 스페이스 목록 (기본값: CONFLUENCE_SPACES 환경변수)")
+	cql := flag.String("cql", "", "수집할 페이지를 조회하는 추가 CQL 조건 (기본값: CONFLUENCE_CQL 환경변수)")
+	concurrency := flag.Int("concurrency", 4, "동시에 조회할 최대 페이지 수")
+	rate := flag.Float64("rate", 5, "초당 최대 요청 수")
+	reconcile := flag.Duration("reconcile", 7*24*time.Hour, "마지막 전체 수집 후 이 기간이 지나면 삭제된 레코드를 빼기 위해 전체를 다시 수집 (0이면 사용하지 않음)")
+	flag.Parse()
+
+	if *concurrency < 1 || *rate <= 0 || *reconcile < 0 {
+		fmt.Println("❌ --concurrency는 1 이상, --rate는 0보다 커야 하고, --reconcile은 음수일 수 없습니다")
 		os.Exit(1)
 	}
-	fmt.Println("JIRA_BASE_URL:", config.BaseURL)
-	fmt.Println("JIRA_PROJECT:", config.Project)
-
-	fmt.Printf("🚀 JIRA 이슈 수집 시작... (프로젝트: %s)\n", config.Project)
 
 	client := &http.Client{
 		Timeout: timeout,
 	}
 
-	var allIssues []map[string]any
-	startAt := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-sig
+		cancel()
+	}()
 
-	for {
-		issues, err := fetchIssues(client, config, startAt)
+	// 설정 로드
+	var src source
+	switch *sourceName {
+	case jiraSourceName:
+		config, err := loadJiraConfig(*projects, *jql, *fields)
 		if err != nil {
-			fmt.Printf("❌ 이슈 수집 실패: %v\n", err)
+			fmt.Printf("❌ 설정 로드 실패: %v\n", err)
 			os.Exit(1)
 		}
-
-		allIssues = append(allIssues, issues...)
-		fmt.Printf("📥 %d ~ %d번까지 수집 완료\n", startAt, startAt+len(issues))
-
-		if len(issues) < maxResults {
-			break
+		fmt.Println("JIRA_BASE_URL:", config.BaseURL)
+		fmt.Println("JIRA_PROJECT:", strings.Join(config.Projects, ","))
+		fmt.Println("JIRA_JQL:", config.JQL)
+		fmt.Println("JIRA_FIELDS:", strings.Join(config.Fields, ","))
+		jiraSrc := &jiraSource{client: client, config: config}
+		// 시간대를 모르면 워터마크를 앞당겨 조회하므로 수집은 계속한다.
+		if err := jiraSrc.loadTimeZone(ctx); err != nil {
+			fmt.Printf("⚠️  Jira 사용자 시간대 조회 실패, 워터마크를 %s 앞당겨 조회합니다: %v\n", watermarkMargin, err)
+		} else {
+			fmt.Println("JIRA_TIME_ZONE:", jiraSrc.location)
 		}
-
-		startAt += maxResults
-	}
-
-	// 결과 저장
-	if err := saveIssues(allIssues); err != nil {
-		fmt.Printf("❌ 파일 저장 실패: %v\n", err)
+		src = jiraSrc
+	case confluenceSourceName:
+		config, err := loadConfluenceConfig(*spaces, *cql)
+		if err != nil {
+			fmt.Printf("❌ 설정 로드 실패: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("CONFLUENCE_BASE_URL:", config.BaseURL)
+		fmt.Println("CONFLUENCE_SPACES:", strings.Join(config.Spaces, ","))
+		fmt.Println("CONFLUENCE_CQL:", config.CQL)
+		src = newConfluenceSource(client, config)
+	default:
+		fmt.Printf("❌ 알 수 없는 수집 원본입니다: %s\n", *sourceName)
 		os.Exit(1)
 	}
 
-	fmt.Printf("\n✅ 총 %d개의 이슈를 다음 경로에 저장했습니다:\n%s\n",
-		len(allIssues), filepath.Join(outputDir, outputFile))
-}
-
-// 설정 로드
-func loadConfig() (*Config, error) {
-	token := os.Getenv("JIRA_TOKEN")
-	if token == "" {
-		return nil, fmt.Errorf("JIRA_TOKEN 환경변수가 설정되지 않았습니다")
-	}
-
-	baseURL := os.Getenv("JIRA_BASE_URL")
-	if baseURL == "" {
-		return nil, fmt.Errorf("JIRA_BASE_URL 환경변수가 설정되지 않았습니다")
+	f := &fetcher{
+		src:         src,
+		limiter:     newRateLimiter(*rate, *concurrency),
+		concurrency: *concurrency,
+		backoff:     retryBackoff,
 	}
 
-	project := os.Getenv("JIRA_PROJECT")
-	if project == "" {
-		return nil, fmt.Errorf("JIRA_PROJECT 환경변수가 설정되지 않았습니다")
-	}
-
-	return &Config{
-		Token:   token,
-		BaseURL: baseURL,
-		Project: project,
-	}, nil
-}
-
-func fetchIssues(client *http.Client, config *Config, startAt int) ([]map[string]any, error) {
-	var issues []map[string]any
-
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		req, err := createRequest(config, startAt)
-		if err != nil {
-			return nil, fmt.Errorf("요청 생성 실패: %w", err)
-		}
-
-		resp, err := client.Do(req)
-		if err != nil {
-			fmt.Printf("⚠️  %d~ 요청 실패 (시도 %d/%d): %v\n", startAt, attempt, maxRetries, err)
-			if attempt == maxRetries {
-				return nil, fmt.Errorf("최대 재시도 횟수 초과")
-			}
-			time.Sleep(3 * time.Second)
-			continue
-		}
-
-		defer func() { _ = resp.Body.Close() }()
-
-		if resp.StatusCode != http.StatusOK {
-			fmt.Printf("⚠️  HTTP 오류 (시도 %d/%d): %d %s\n", attempt, maxRetries, resp.StatusCode, resp.Status)
-			if attempt == maxRetries {
-				return nil, fmt.Errorf("HTTP 오류: %d %s", resp.StatusCode, resp.Status)
-			}
-			time.Sleep(3 * time.Second)
-			continue
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Printf("⚠️  응답 읽기 실패 (시도 %d/%d): %v\n", attempt, maxRetries, err)
-			if attempt == maxRetries {
-				return nil, fmt.Errorf("응답 읽기 실패: %w", err)
-			}
-			time.Sleep(3 * time.Second)
-			continue
+	failed := false
+	for _, t := range src.targets() {
+		if ctx.Err() != nil {
+			break
 		}
-
-		var jiraResp JiraResponse
-		if err := json.Unmarshal(body, &jiraResp); err != nil {
-			fmt.Printf("⚠️  JSON 파싱 실패 (시도 %d/%d): %v\n", attempt, maxRetries, err)
-			if attempt == maxRetries {
-				return nil, fmt.Errorf("JSON 파싱 실패: %w", err)
-			}
-			time.Sleep(3 * time.Second)
-			continue
+		if err := collect(ctx, f, t, *full, *reconcile); err != nil {
+			fmt.Printf("❌ %s %s 수집 실패: %v\n", t.Name, src.label(), err)
+			failed = true
 		}
-
-		return jiraResp.Issues, nil
-	}
-
-	return issues, nil
-}
-
-func createRequest(config *Config, startAt int) (*http.Request, error) {
-	u, err := url.Parse(config.BaseURL)
-	if err != nil {
-		return nil, err
 	}
-
-	q := u.Query()
-	q.Set("jql", fmt.Sprintf("project=%s", config.Project))
-	q.Set("startAt", strconv.Itoa(startAt))
-	q.Set("maxResults", strconv.Itoa(maxResults))
-	q.Set("fields", "*all")
-	u.RawQuery = q.Encode()
-
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+config.Token)
-	req.Header.Set("Content-Type", "application/json")
-
-	return req, nil
-}
-
-func saveIssues(issues []map[string]any) error {
-	// 출력 디렉토리 생성
-	if err := os.MkdirAll(outputDir, 0755); err != nil {
-		return fmt.Errorf("디렉토리 생성 실패: %w", err)
-	}
-
-	// JSON 파일로 저장
-	outputPath := filepath.Join(outputDir, outputFile)
-	file, err := os.Create(outputPath)
-	if err != nil {
-		return fmt.Errorf("파일 생성 실패: %w", err)
-	}
-	defer func() { _ = file.Close() }()
-
-	encoder := json.NewEncoder(file)
-	encoder.SetIndent("", "    ")
-	encoder.SetEscapeHTML(false)
-
-	if err := encoder.Encode(issues); err != nil {
-		return fmt.Errorf("JSON 인코딩 실패: %w", err)
+	if failed {
+		os.Exit(1)
 	}
-
-	return nil
 }
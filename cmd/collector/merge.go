@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+// 변경된 이슈를 키 기준으로 기존 이슈 파일에 병합합니다.
+// 이미 있는 이슈는 같은 위치에서 교체하고, 새 이슈는 파일 끝에 추가합니다. 병합된 전체 이슈 수를 반환합니다.
+func mergeIssues(existingPath, changedPath string) (int, error) {
+	// 변경분은 전체 데이터보다 훨씬 작으므로 메모리에 올려 둔다.
+	changed := map[string]json.RawMessage{}
+	var keys []string
+	err := readIssues(changedPath, func(issue json.RawMessage) error {
+		header := issueHeader{}
+		if err := json.Unmarshal(issue, &header); err != nil {
+			return fmt.Errorf("이슈 파싱 실패: %w", err)
+		}
+		if _, ok := changed[header.Key]; !ok {
+			keys = append(keys, header.Key)
+		}
+		changed[header.Key] = issue
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = writeFileAtomic(existingPath, func(w io.Writer) error {
+		write := func(issue json.RawMessage) error {
+			count++
+			if _, err := w.Write(issue); err != nil {
+				return err
+			}
+			_, err := w.Write([]byte{'\n'})
+			return err
+		}
+
+		err := readIssues(existingPath, func(issue json.RawMessage) error {
+			header := issueHeader{}
+			if err := json.Unmarshal(issue, &header); err != nil {
+				return fmt.Errorf("이슈 파싱 실패: %w", err)
+			}
+			if c, ok := changed[header.Key]; ok {
+				issue = c
+				delete(changed, header.Key)
+			}
+			return write(issue)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if issue, ok := changed[key]; ok {
+				if err := write(issue); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// 이슈 파일의 이슈 수와 가장 최근 수정일을 계산합니다. since 보다 최근인 값이 없으면 since를 그대로 반환합니다.
+func latestUpdated(path string, since string) (int, string, error) {
+	latest := since
+	var latestTime time.Time
+	if since != "" {
+		t, err := jira.ParseTime(since)
+		if err != nil {
+			return 0, "", fmt.Errorf("워터마크 파싱 실패: %w", err)
+		}
+		latestTime = t
+	}
+
+	count := 0
+	err := readIssues(path, func(issue json.RawMessage) error {
+		count++
+
+		header := issueHeader{}
+		if err := json.Unmarshal(issue, &header); err != nil {
+			return fmt.Errorf("이슈 파싱 실패: %w", err)
+		}
+		if header.Fields.Updated == "" {
+			return nil
+		}
+
+		t, err := jira.ParseTime(header.Fields.Updated)
+		if err != nil {
+			return fmt.Errorf("이슈 수정일 파싱 실패: %w", err)
+		}
+		if t.After(latestTime) {
+			latest, latestTime = header.Fields.Updated, t
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	return count, latest, nil
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// 테스트용 JSON Lines 파일을 만듭니다.
+func writeLines(t *testing.T, path string, lines ...string) {
+	t.Helper()
+
+	data := strings.Join(lines, "\n")
+	if len(lines) > 0 {
+		data += "\n"
+	}
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+// 파일의 이슈를 한 줄씩 읽습니다.
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+
+	var lines []string
+	err := readIssues(path, func(issue json.RawMessage) error {
+		lines = append(lines, string(issue))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to read issues: %v", err)
+	}
+	return lines
+}
+
+func TestMergeIssues(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		existing []string
+		changed  []string
+		expected []string
+	}{
+		{
+			desc:     "replace in place",
+			existing: []string{`{"key":"AA-1","v":1}`, `{"key":"AA-2","v":1}`, `{"key":"AA-3","v":1}`},
+			changed:  []string{`{"key":"AA-2","v":2}`},
+			expected: []string{`{"key":"AA-1","v":1}`, `{"key":"AA-2","v":2}`, `{"key":"AA-3","v":1}`},
+		},
+		{
+			desc:     "append new issues in fetched order",
+			existing: []string{`{"key":"AA-1","v":1}`},
+			changed:  []string{`{"key":"AA-3","v":1}`, `{"key":"AA-1","v":2}`, `{"key":"AA-2","v":1}`},
+			expected: []string{`{"key":"AA-1","v":2}`, `{"key":"AA-3","v":1}`, `{"key":"AA-2","v":1}`},
+		},
+		{
+			desc:     "last duplicate wins",
+			existing: []string{`{"key":"AA-1","v":1}`},
+			changed:  []string{`{"key":"AA-1","v":2}`, `{"key":"AA-1","v":3}`},
+			expected: []string{`{"key":"AA-1","v":3}`},
+		},
+		{
+			desc:     "no changes",
+			existing: []string{`{"key":"AA-1","v":1}`},
+			expected: []string{`{"key":"AA-1","v":1}`},
+		},
+		{
+			desc:     "empty existing file",
+			changed:  []string{`{"key":"AA-1","v":1}`},
+			expected: []string{`{"key":"AA-1","v":1}`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			dir := t.TempDir()
+			existing := filepath.Join(dir, "issues.jsonl")
+			changed := filepath.Join(dir, "issues.jsonl.partial")
+			writeLines(t, existing, tc.existing...)
+			writeLines(t, changed, tc.changed...)
+
+			count, err := mergeIssues(existing, changed)
+			if err != nil {
+				t.Fatalf("failed to merge: %v", err)
+			}
+
+			if count != len(tc.expected) {
+				t.Errorf("expected count %d, got %d", len(tc.expected), count)
+			}
+			if got := readLines(t, existing); !slices.Equal(got, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestLatestUpdated(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		lines         []string
+		since         string
+		expectCount   int
+		expectUpdated string
+		expectErr     bool
+	}{
+		{
+			desc: "latest of issues",
+			lines: []string{
+				`{"key":"AA-1","fields":{"updated":"2025-07-25T13:59:32.000+0900"}}`,
+				`{"key":"AA-2","fields":{"updated":"2025-07-26T09:00:00.000+0900"}}`,
+				`{"key":"AA-3","fields":{"updated":"2025-07-24T09:00:00.000+0900"}}`,
+			},
+			expectCount:   3,
+			expectUpdated: "2025-07-26T09:00:00.000+0900",
+		},
+		{
+			desc: "compare instants across offsets",
+			lines: []string{
+				`{"key":"AA-1","fields":{"updated":"2025-07-26T09:00:00.000+0900"}}`,
+				`{"key":"AA-2","fields":{"updated":"2025-07-26T01:00:00.000+0000"}}`,
+			},
+			expectCount:   2,
+			expectUpdated: "2025-07-26T01:00:00.000+0000",
+		},
+		{
+			desc:          "keep since when nothing is newer",
+			lines:         []string{`{"key":"AA-1","fields":{"updated":"2025-07-24T09:00:00.000+0900"}}`},
+			since:         "2025-07-25T09:00:00.000+0900",
+			expectCount:   1,
+			expectUpdated: "2025-07-25T09:00:00.000+0900",
+		},
+		{
+			desc:          "skip issues without updated",
+			lines:         []string{`{"key":"AA-1","fields":{}}`},
+			expectCount:   1,
+			expectUpdated: "",
+		},
+		{
+			desc:      "invalid updated",
+			lines:     []string{`{"key":"AA-1","fields":{"updated":"yesterday"}}`},
+			expectErr: true,
+		},
+		{
+			desc:      "invalid since",
+			since:     "yesterday",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "issues.jsonl")
+			writeLines(t, path, tc.lines...)
+
+			count, updated, err := latestUpdated(path, tc.since)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to compute watermark: %v", err)
+			}
+
+			if count != tc.expectCount {
+				t.Errorf("expected count %d, got %d", tc.expectCount, count)
+			}
+			if updated != tc.expectUpdated {
+				t.Errorf("expected updated %q, got %q", tc.expectUpdated, updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 토큰 버킷 방식의 요청 속도 제한기.
+// 모든 페이지 요청이 하나의 제한기를 공유해서 Jira의 요청 제한에 걸리지 않도록 합니다.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// 초당 채워지는 토큰 수.
+	rate float64
+	// 버킷에 담을 수 있는 최대 토큰 수.
+	burst float64
+	// 현재 토큰 수.
+	tokens float64
+	// 마지막으로 토큰을 채운 시각.
+	last time.Time
+	// 이 시각까지는 모든 요청을 멈춥니다. (429 응답의 Retry-After)
+	pausedUntil time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// 토큰을 하나 얻을 때까지 기다립니다.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := l.reserve()
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// 토큰을 얻으면 0을, 아니면 다시 시도할 때까지 기다려야 하는 시간을 반환합니다.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// d 동안 모든 요청을 멈춥니다. 멈춘 뒤에는 빈 버킷에서 다시 시작합니다.
+func (l *rateLimiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	l.tokens = 0
+	l.last = l.pausedUntil
+}
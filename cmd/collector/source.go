@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// 수집 원본. Jira와 Confluence가 같은 수집 흐름(체크포인트, 증분 수집, 병합)을 사용합니다.
+type source interface {
+	// 로그에 표시할 수집 대상 이름. e.g., "JIRA 이슈"
+	label() string
+	// 설정의 수집 대상 목록.
+	targets() []target
+	// 대상의 조회 조건에 증분 수집 조건과 정렬 조건을 붙입니다. since가 비어 있으면 전체를 조회합니다.
+	buildQuery(query, since string) (string, error)
+	// startAt 위치부터 한 페이지를 조회합니다.
+	// 오류가 HTTP 응답 때문이면 재시도 여부를 판단할 수 있도록 *statusError를 반환해야 합니다.
+	fetch(ctx context.Context, query string, startAt int) (*page, error)
+}
+
+// 조회한 한 페이지의 레코드.
+// 레코드는 출력 파일에 한 줄씩 그대로 기록되며, 병합과 워터마크 계산에 key와 fields.updated를 사용합니다.
+type page struct {
+	Records []json.RawMessage
+	// 조건에 맞는 전체 레코드 수. 알 수 없으면 0이며, 이 경우 페이지를 차례대로 조회합니다.
+	Total int
+}
+
+// 수집 대상. 대상마다 출력 디렉토리와 수집 상태를 따로 관리합니다.
+type target struct {
+	// 출력 디렉토리 이름. e.g., "AA", "confluence/DEV"
+	Name string
+	// 출력 파일 이름. e.g., "issues.jsonl"
+	File string
+	// 수집할 레코드를 조회하는 조건(JQL, CQL). 증분 수집 조건은 포함하지 않습니다.
+	Query string
+}
+
+// 쉼표로 구분된 목록을 나눕니다. 빈 항목은 제외합니다.
+func splitList(s string) []string {
+	var items []string
+	for item := range strings.SplitSeq(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// 수집 상태 정보.
+type State struct {
+	// 마지막으로 성공한 수집의 조회 조건(JQL, CQL). 대상의 조회 조건이 바뀌면 전체 수집합니다.
+	Query string `json:"query"`
+	// 마지막으로 성공한 수집에서 확인한 가장 최근 이슈 수정일. (2006-01-02T15:04:05Z0700)
+	Updated string `json:"updated"`
+	// 마지막으로 전체 수집을 마친 시각. (RFC 3339)
+	FullCollected string `json:"fullCollected,omitempty"`
+	// 진행 중인 수집의 체크포인트. 수집이 끝나면 비워집니다.
+	Checkpoint *Checkpoint `json:"checkpoint,omitempty"`
+}
+
+// 중단된 수집을 이어서 진행하기 위한 페이지네이션 위치.
+type Checkpoint struct {
+	// 수집 중인 조회 조건(JQL, CQL). 조회 조건이 바뀌면 체크포인트를 사용하지 않습니다.
+	Query string `json:"query"`
+	// 다음에 조회할 페이지의 시작 위치.
+	StartAt int `json:"startAt"`
+	// 수집 중인 파일에 기록이 완료된 바이트 수.
+	Offset int64 `json:"offset"`
+}
+
+// 이전 버전은 조회 조건을 jql 필드에 저장했으므로 query 필드가 없으면 jql 필드를 읽습니다.
+func (s *State) UnmarshalJSON(data []byte) error {
+	type state State
+	v := struct {
+		state
+		JQL string `json:"jql"`
+	}{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*s = State(v.state)
+	if s.Query == "" {
+		s.Query = v.JQL
+	}
+	return nil
+}
+
+// 이전 버전은 조회 조건을 jql 필드에 저장했으므로 query 필드가 없으면 jql 필드를 읽습니다.
+func (c *Checkpoint) UnmarshalJSON(data []byte) error {
+	type checkpoint Checkpoint
+	v := struct {
+		checkpoint
+		JQL string `json:"jql"`
+	}{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*c = Checkpoint(v.checkpoint)
+	if c.Query == "" {
+		c.Query = v.JQL
+	}
+	return nil
+}
+
+// 수집 상태 로드. 파일이 없으면 빈 상태를 반환합니다.
+func loadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &State{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("상태 파일 읽기 실패: %w", err)
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("상태 파일 파싱 실패: %w", err)
+	}
+
+	return state, nil
+}
+
+// 수집 상태 저장
+func saveState(path string, state *State) error {
+	data, err := json.MarshalIndent(state, "", "    ")
+	if err != nil {
+		return fmt.Errorf("상태 인코딩 실패: %w", err)
+	}
+
+	return writeFileAtomic(path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// 마지막 전체 수집 후 interval이 지났는지 확인합니다. interval이 0이면 항상 false를 반환합니다.
+// 증분 수집은 수정된 이슈만 조회하므로, 삭제되거나 다른 프로젝트로 이동한 이슈는 전체 수집으로만 결과에서 빠집니다.
+func reconcileDue(state *State, interval time.Duration, now time.Time) bool {
+	if interval <= 0 {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, state.FullCollected)
+	if err != nil {
+		return true
+	}
+	return !now.Before(t.Add(interval))
+}
+
+// 같은 조회 조건으로 중단된 수집이 있고 수집 중인 파일이 남아 있으면 그 체크포인트를, 아니면 처음부터 시작하는 체크포인트를 반환합니다.
+func resumeCheckpoint(state *State, query, partialPath string) *Checkpoint {
+	cp := state.Checkpoint
+	if cp == nil || cp.Query != query {
+		return &Checkpoint{Query: query}
+	}
+
+	info, err := os.Stat(partialPath)
+	if err != nil || info.Size() < cp.Offset {
+		return &Checkpoint{Query: query}
+	}
+
+	return cp
+}
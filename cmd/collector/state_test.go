@@ -0,0 +1,221 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReconcileDue(t *testing.T) {
+	now := time.Date(2025, 7, 25, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		desc          string
+		fullCollected string
+		interval      time.Duration
+		expected      bool
+	}{
+		{
+			desc:          "disabled",
+			fullCollected: "",
+			interval:      0,
+			expected:      false,
+		},
+		{
+			desc:          "never fully collected",
+			fullCollected: "",
+			interval:      24 * time.Hour,
+			expected:      true,
+		},
+		{
+			desc:          "recently collected",
+			fullCollected: "2025-07-25T00:00:00Z",
+			interval:      24 * time.Hour,
+			expected:      false,
+		},
+		{
+			desc:          "interval elapsed",
+			fullCollected: "2025-07-24T12:00:00Z",
+			interval:      24 * time.Hour,
+			expected:      true,
+		},
+		{
+			desc:          "invalid time",
+			fullCollected: "yesterday",
+			interval:      24 * time.Hour,
+			expected:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := reconcileDue(&State{FullCollected: tc.fullCollected}, tc.interval, now)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResumeCheckpoint(t *testing.T) {
+	const query = "project=AA ORDER BY key ASC"
+	saved := &Checkpoint{Query: query, StartAt: 200, Offset: 10}
+
+	testCases := []struct {
+		desc       string
+		checkpoint *Checkpoint
+		partial    string
+		noPartial  bool
+		query      string
+		expected   *Checkpoint
+	}{
+		{
+			desc:       "resume",
+			checkpoint: saved,
+			partial:    "0123456789",
+			query:      query,
+			expected:   saved,
+		},
+		{
+			desc:       "resume with trailing incomplete line",
+			checkpoint: saved,
+			partial:    "0123456789{\"key\":",
+			query:      query,
+			expected:   saved,
+		},
+		{
+			desc:     "no checkpoint",
+			partial:  "0123456789",
+			query:    query,
+			expected: &Checkpoint{Query: query},
+		},
+		{
+			desc:       "query changed",
+			checkpoint: saved,
+			partial:    "0123456789",
+			query:      `(project=AA) AND updated >= "2025-07-25 13:59" ORDER BY key ASC`,
+			expected:   &Checkpoint{Query: `(project=AA) AND updated >= "2025-07-25 13:59" ORDER BY key ASC`},
+		},
+		{
+			desc:       "partial file missing",
+			checkpoint: saved,
+			noPartial:  true,
+			query:      query,
+			expected:   &Checkpoint{Query: query},
+		},
+		{
+			desc:       "partial file shorter than checkpoint",
+			checkpoint: saved,
+			partial:    "01234",
+			query:      query,
+			expected:   &Checkpoint{Query: query},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "issues.jsonl.partial")
+			if !tc.noPartial {
+				if err := os.WriteFile(path, []byte(tc.partial), 0644); err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			got := resumeCheckpoint(&State{Checkpoint: tc.checkpoint}, tc.query, path)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestStateRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	// 상태 파일이 없으면 빈 상태로 시작한다.
+	state, err := loadState(path)
+	if err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	if !reflect.DeepEqual(state, &State{}) {
+		t.Errorf("expected empty state, got %+v", state)
+	}
+
+	expected := &State{
+		Query:      "project=AA",
+		Updated:    "2025-07-25T13:59:32.000+0900",
+		Checkpoint: &Checkpoint{Query: "project=AA ORDER BY key ASC", StartAt: 100, Offset: 2048},
+	}
+	if err := saveState(path, expected); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+	got, err := loadState(path)
+	if err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if _, err := loadState(path); err == nil {
+		t.Error("expected error for corrupt state, got nil")
+	}
+}
+
+func TestLoadStateLegacyQuery(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		data     string
+		expected *State
+	}{
+		{
+			desc: "legacy jql fields",
+			data: `{"jql":"project=AA","updated":"2025-07-25T13:59:32.000+0900","checkpoint":{"jql":"project=AA ORDER BY key ASC","startAt":100,"offset":2048}}`,
+			expected: &State{
+				Query:      "project=AA",
+				Updated:    "2025-07-25T13:59:32.000+0900",
+				Checkpoint: &Checkpoint{Query: "project=AA ORDER BY key ASC", StartAt: 100, Offset: 2048},
+			},
+		},
+		{
+			desc:     "query field wins",
+			data:     `{"query":"space=DEV","jql":"project=AA"}`,
+			expected: &State{Query: "space=DEV"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "state.json")
+			if err := os.WriteFile(path, []byte(tc.data), 0644); err != nil {
+				t.Fatalf("failed to write file: %v", err)
+			}
+
+			got, err := loadState(path)
+			if err != nil {
+				t.Fatalf("failed to load state: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+
+			// 다시 저장하면 query 필드만 기록한다.
+			if err := saveState(path, got); err != nil {
+				t.Fatalf("failed to save state: %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+			if strings.Contains(string(data), `"jql"`) {
+				t.Errorf("expected no jql field, got %s", data)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/pkg/cache"
+)
+
+var (
+	_ service.Embedder = (*CachedEmbedder)(nil)
+	_ expvar.Var       = (*CachedEmbedder)(nil)
+)
+
+type cacheOptions struct {
+	// 임베딩을 저장하는 기간. 지나면 다시 임베딩합니다.
+	ttl time.Duration
+	// 캐시를 저장할 파일. 비어 있으면 메모리에만 저장합니다.
+	file string
+}
+
+var defaultCacheOptions = cacheOptions{
+	ttl: 24 * time.Hour,
+}
+
+type CacheOption func(*cacheOptions)
+
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(opt *cacheOptions) {
+		opt.ttl = ttl
+	}
+}
+
+// 캐시를 file에서 읽고 Save를 호출하면 file에 저장합니다. 재시작해도 캐시를 유지할 때 사용합니다.
+func WithCacheFile(file string) CacheOption {
+	return func(opt *cacheOptions) {
+		opt.file = file
+	}
+}
+
+// 쿼리 임베딩을 LRU 캐시에 저장해서 같은 쿼리를 다시 임베딩하지 않는 Embedder.
+// 키는 모델 이름과 공백을 정리한 쿼리이고, 임베딩 서버에는 쿼리 원문을 보냅니다.
+type CachedEmbedder struct {
+	next  service.Embedder
+	model string
+	cache *cache.LRU[cacheKey, cachedVector]
+
+	options *cacheOptions
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+type cacheKey struct {
+	model string
+	text  string
+}
+
+type cachedVector struct {
+	vector    []float32
+	expiresAt time.Time
+}
+
+// 캐시 파일의 한 줄.
+type cacheRecord struct {
+	Model     string    `json:"model"`
+	Text      string    `json:"text"`
+	Vector    []float32 `json:"vector"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// next의 임베딩을 최대 capacity개까지 저장합니다. 캐시 파일이 있으면 만료되지 않은 임베딩을 읽어 둡니다.
+func NewCachedEmbedder(next service.Embedder, model string, capacity int, opts ...CacheOption) (*CachedEmbedder, error) {
+	options := defaultCacheOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	c := &CachedEmbedder{
+		next:    next,
+		model:   model,
+		cache:   cache.NewLRU[cacheKey, cachedVector](capacity),
+		options: &options,
+	}
+	if options.file != "" {
+		if err := c.load(options.file); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *CachedEmbedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	key := normalize(text)
+	if vector, ok := c.get(key); ok {
+		return vector, nil
+	}
+
+	vector, err := c.next.Embed(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+	c.add(key, vector)
+	return slices.Clone(vector), nil
+}
+
+// 캐시에 없는 쿼리만 한 번에 임베딩합니다. 키가 같은 쿼리는 처음 나온 쿼리 하나만 임베딩합니다.
+func (c *CachedEmbedder) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	var (
+		// 임베딩할 쿼리 원문과 캐시 키.
+		misses []string
+		keys   []string
+		// 캐시에 없는 쿼리의 위치와 그 쿼리를 임베딩한 misses의 위치.
+		indexes []int
+		slots   []int
+	)
+	seen := make(map[string]int)
+	for i, text := range texts {
+		key := normalize(text)
+		if vector, ok := c.get(key); ok {
+			vectors[i] = vector
+			continue
+		}
+
+		slot, ok := seen[key]
+		if !ok {
+			slot = len(misses)
+			seen[key] = slot
+			misses = append(misses, text)
+			keys = append(keys, key)
+		}
+		indexes = append(indexes, i)
+		slots = append(slots, slot)
+	}
+	if len(misses) == 0 {
+		return vectors, nil
+	}
+
+	embedded, err := c.next.EmbedBatch(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	if len(embedded) != len(misses) {
+		return nil, fmt.Errorf("unexpected embedding count: got %d, want %d", len(embedded), len(misses))
+	}
+	for i, key := range keys {
+		c.add(key, embedded[i])
+	}
+	for i, idx := range indexes {
+		vectors[idx] = slices.Clone(embedded[slots[i]])
+	}
+	return vectors, nil
+}
+
+// 호출한 쪽이 벡터를 바꿔도 캐시가 바뀌지 않도록 복사본을 반환합니다.
+func (c *CachedEmbedder) get(text string) ([]float32, bool) {
+	key := cacheKey{model: c.model, text: text}
+	v, ok := c.cache.Get(key)
+	if ok && !time.Now().Before(v.expiresAt) {
+		c.cache.Remove(key)
+		ok = false
+	}
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	c.hits.Add(1)
+	return slices.Clone(v.vector), true
+}
+
+func (c *CachedEmbedder) add(text string, vector []float32) {
+	c.cache.Add(cacheKey{model: c.model, text: text}, cachedVector{
+		vector:    slices.Clone(vector),
+		expiresAt: time.Now().Add(c.options.ttl),
+	})
+}
+
+// 캐시 키로 사용할 쿼리. 앞뒤 공백을 없애고 연속된 공백을 하나로 줄여서, 공백만 다른 쿼리는 같은 임베딩을 사용합니다.
+func normalize(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// 캐시 적중 수와 실패 수.
+func (c *CachedEmbedder) Stats() (hits, misses int64) {
+	return c.hits.Load(), c.misses.Load()
+}
+
+// 캐시 적중 수, 실패 수, 적중률, 저장된 임베딩 수를 로그로 남깁니다.
+func (c *CachedEmbedder) LogSummary() {
+	v := c.vars()
+	slog.Info("embedding cache stats",
+		"hits", v.Hits,
+		"misses", v.Misses,
+		"hit_ratio", v.HitRatio,
+		"size", v.Size,
+	)
+}
+
+// expvar로 공개하는 캐시 집계.
+type cacheVar struct {
+	Hits     int64   `json:"hits"`
+	Misses   int64   `json:"misses"`
+	HitRatio float64 `json:"hit_ratio"`
+	Size     int     `json:"size"`
+}
+
+func (c *CachedEmbedder) vars() cacheVar {
+	hits, misses := c.Stats()
+	ratio := 0.0
+	if total := hits + misses; total > 0 {
+		ratio = float64(hits) / float64(total)
+	}
+	return cacheVar{Hits: hits, Misses: misses, HitRatio: ratio, Size: c.cache.Len()}
+}
+
+// 캐시 적중 수, 실패 수, 적중률, 저장된 임베딩 수를 JSON으로 반환합니다.
+func (c *CachedEmbedder) String() string {
+	data, err := json.Marshal(c.vars())
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+// 캐시 파일을 읽습니다. 파일이 없으면 빈 캐시로 시작하고, 읽을 수 없는 줄은 건너뜁니다.
+func (c *CachedEmbedder) load(path string) error {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to open embedding cache: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	now := time.Now()
+	loaded := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var r cacheRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			slog.Warn("skipped invalid embedding cache entry", slog.String("file", path), slog.Int("line", line), slog.Any("error", err))
+			continue
+		}
+		// 다른 모델로 만든 임베딩은 키가 달라서 사용하지 않으므로 읽지 않는다.
+		if r.Model != c.model || !now.Before(r.ExpiresAt) {
+			continue
+		}
+		c.cache.Add(cacheKey{model: r.Model, text: r.Text}, cachedVector{vector: r.Vector, expiresAt: r.ExpiresAt})
+		loaded++
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read embedding cache: %w", err)
+	}
+
+	slog.Info("loaded embedding cache", slog.String("file", path), slog.Int("count", loaded))
+	return nil
+}
+
+// 만료되지 않은 임베딩을 캐시 파일에 저장합니다. 캐시 파일을 설정하지 않았으면 아무것도 하지 않습니다.
+// 같은 디렉토리의 임시 파일에 기록한 뒤 이름을 바꾸므로, 중간에 실패해도 기존 파일은 그대로 남습니다.
+func (c *CachedEmbedder) Save() error {
+	path := c.options.file
+	if path == "" {
+		return nil
+	}
+
+	// 순회하는 동안 캐시가 잠기므로 파일에 쓰기 전에 먼저 모아 둔다.
+	// 오래전에 사용한 임베딩부터 저장해서, 읽을 때 같은 순서로 추가하면 사용 순서가 유지된다.
+	now := time.Now()
+	var records []cacheRecord
+	for key, v := range c.cache.All() {
+		if now.Before(v.expiresAt) {
+			records = append(records, cacheRecord{Model: key.model, Text: key.text, Vector: v.vector, ExpiresAt: v.expiresAt})
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create embedding cache: %w", err)
+	}
+	// 이름을 바꾼 뒤에는 임시 파일이 없으므로 삭제 실패는 무시한다.
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		if err = enc.Encode(r); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write embedding cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write embedding cache: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save embedding cache: %w", err)
+	}
+	return nil
+}
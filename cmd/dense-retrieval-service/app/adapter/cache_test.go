@@ -0,0 +1,430 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/adapter"
+)
+
+// 받은 텍스트를 기록하고 텍스트 길이를 벡터로 반환하는 Embedder.
+type fakeEmbedder struct {
+	texts   []string
+	batches [][]string
+	err     error
+}
+
+func (f *fakeEmbedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	f.texts = append(f.texts, text)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []float32{float32(len(text))}, nil
+}
+
+func (f *fakeEmbedder) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	f.batches = append(f.batches, texts)
+	if f.err != nil {
+		return nil, f.err
+	}
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text))}
+	}
+	return vectors, nil
+}
+
+func newCachedEmbedder(t *testing.T, next *fakeEmbedder, opts ...adapter.CacheOption) *adapter.CachedEmbedder {
+	t.Helper()
+
+	c, err := adapter.NewCachedEmbedder(next, "model", 10, opts...)
+	if err != nil {
+		t.Fatalf("failed to create cache: %v", err)
+	}
+	return c
+}
+
+func TestCachedEmbedderEmbed(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		queries      []string
+		ttl          time.Duration
+		expectTexts  []string
+		expectHits   int64
+		expectMisses int64
+	}{
+		{
+			desc:         "same query",
+			queries:      []string{"hello world", "hello world"},
+			ttl:          time.Hour,
+			expectTexts:  []string{"hello world"},
+			expectHits:   1,
+			expectMisses: 1,
+		},
+		{
+			desc:         "whitespace only differs",
+			queries:      []string{"  hello   world ", "hello world"},
+			ttl:          time.Hour,
+			expectTexts:  []string{"  hello   world "},
+			expectHits:   1,
+			expectMisses: 1,
+		},
+		{
+			desc:         "different queries",
+			queries:      []string{"hello", "world"},
+			ttl:          time.Hour,
+			expectTexts:  []string{"hello", "world"},
+			expectMisses: 2,
+		},
+		{
+			desc:         "expired query",
+			queries:      []string{"hello", "hello"},
+			ttl:          time.Nanosecond,
+			expectTexts:  []string{"hello", "hello"},
+			expectMisses: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			next := &fakeEmbedder{}
+			c := newCachedEmbedder(t, next, adapter.WithCacheTTL(tc.ttl))
+
+			for _, q := range tc.queries {
+				vector, err := c.Embed(context.Background(), q)
+				if err != nil {
+					t.Fatalf("failed to embed: %v", err)
+				}
+				// 임베딩 서버에 처음 보낸 원문의 임베딩을 사용한다.
+				if len(vector) != 1 {
+					t.Fatalf("expected 1 dimension, got %d", len(vector))
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			if !slices.Equal(next.texts, tc.expectTexts) {
+				t.Errorf("expected upstream texts %q, got %q", tc.expectTexts, next.texts)
+			}
+			if hits, misses := c.Stats(); hits != tc.expectHits || misses != tc.expectMisses {
+				t.Errorf("expected %d hits and %d misses, got %d and %d", tc.expectHits, tc.expectMisses, hits, misses)
+			}
+		})
+	}
+}
+
+func TestCachedEmbedderString(t *testing.T) {
+	c := newCachedEmbedder(t, &fakeEmbedder{})
+	for _, q := range []string{"hello", "hello", "world", "hello"} {
+		if _, err := c.Embed(context.Background(), q); err != nil {
+			t.Fatalf("failed to embed: %v", err)
+		}
+	}
+
+	var got struct {
+		Hits     int64   `json:"hits"`
+		Misses   int64   `json:"misses"`
+		HitRatio float64 `json:"hit_ratio"`
+		Size     int     `json:"size"`
+	}
+	if err := json.Unmarshal([]byte(c.String()), &got); err != nil {
+		t.Fatalf("failed to parse stats: %v", err)
+	}
+	if got.Hits != 2 || got.Misses != 2 {
+		t.Errorf("expected 2 hits and 2 misses, got %d and %d", got.Hits, got.Misses)
+	}
+	if got.HitRatio != 0.5 {
+		t.Errorf("expected hit ratio 0.5, got %v", got.HitRatio)
+	}
+	if got.Size != 2 {
+		t.Errorf("expected size 2, got %d", got.Size)
+	}
+}
+
+func TestCachedEmbedderEmbedError(t *testing.T) {
+	next := &fakeEmbedder{err: errors.New("embedder unavailable")}
+	c := newCachedEmbedder(t, next)
+
+	if _, err := c.Embed(context.Background(), "hello"); !errors.Is(err, next.err) {
+		t.Fatalf("expected %v, got %v", next.err, err)
+	}
+
+	// 실패한 임베딩은 캐시하지 않는다.
+	next.err = nil
+	if _, err := c.Embed(context.Background(), "hello"); err != nil {
+		t.Fatalf("failed to embed: %v", err)
+	}
+	if len(next.texts) != 2 {
+		t.Errorf("expected 2 upstream calls, got %d", len(next.texts))
+	}
+}
+
+func TestCachedEmbedderEmbedBatch(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		cached       []string
+		queries      []string
+		expectBatch  []string
+		expectHits   int64
+		expectMisses int64
+	}{
+		{
+			desc:         "all misses",
+			queries:      []string{"a", "bb"},
+			expectBatch:  []string{"a", "bb"},
+			expectMisses: 2,
+		},
+		{
+			desc:        "all hits",
+			cached:      []string{"a", "bb"},
+			queries:     []string{"bb", "a"},
+			expectHits:  2,
+			expectBatch: nil,
+		},
+		{
+			desc:         "mixed hits and misses keep order",
+			cached:       []string{"bb"},
+			queries:      []string{"a", "bb", "ccc", "bb"},
+			expectBatch:  []string{"a", "ccc"},
+			expectHits:   2,
+			expectMisses: 2,
+		},
+		{
+			desc:         "duplicate misses embedded once",
+			queries:      []string{"a b", "ccc", " a  b "},
+			expectBatch:  []string{"a b", "ccc"},
+			expectMisses: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			next := &fakeEmbedder{}
+			c := newCachedEmbedder(t, next)
+			for _, q := range tc.cached {
+				if _, err := c.Embed(context.Background(), q); err != nil {
+					t.Fatalf("failed to embed: %v", err)
+				}
+			}
+			hits, misses := c.Stats()
+
+			vectors, err := c.EmbedBatch(context.Background(), tc.queries)
+			if err != nil {
+				t.Fatalf("failed to embed batch: %v", err)
+			}
+
+			if len(vectors) != len(tc.queries) {
+				t.Fatalf("expected %d vectors, got %d", len(tc.queries), len(vectors))
+			}
+			for i, q := range tc.queries {
+				// 가짜 임베딩은 원문 길이이고, 키가 같은 쿼리는 처음 임베딩한 원문의 벡터를 사용한다.
+				if want := float32(len(strings.Join(strings.Fields(q), " "))); len(vectors[i]) != 1 || vectors[i][0] != want {
+					t.Errorf("expected vector of %q at %d, got %v", q, i, vectors[i])
+				}
+			}
+			var batch []string
+			if len(next.batches) > 0 {
+				batch = next.batches[0]
+			}
+			if !slices.Equal(batch, tc.expectBatch) {
+				t.Errorf("expected batch %q, got %q", tc.expectBatch, batch)
+			}
+			gotHits, gotMisses := c.Stats()
+			if gotHits-hits != tc.expectHits || gotMisses-misses != tc.expectMisses {
+				t.Errorf("expected %d hits and %d misses, got %d and %d", tc.expectHits, tc.expectMisses, gotHits-hits, gotMisses-misses)
+			}
+		})
+	}
+}
+
+func TestCachedEmbedderBatchSharesDuplicates(t *testing.T) {
+	next := &fakeEmbedder{}
+	c := newCachedEmbedder(t, next)
+
+	vectors, err := c.EmbedBatch(context.Background(), []string{"a  b", "a b"})
+	if err != nil {
+		t.Fatalf("failed to embed batch: %v", err)
+	}
+	// 두 쿼리 모두 처음 나온 원문 "a  b"의 임베딩을 사용한다.
+	if vectors[0][0] != 4 || vectors[1][0] != 4 {
+		t.Errorf("expected [[4] [4]], got %v", vectors)
+	}
+
+	vectors[0][0] = 99
+	if vectors[1][0] != 4 {
+		t.Errorf("expected separate copies, got %v", vectors)
+	}
+}
+
+func TestCachedEmbedderCopies(t *testing.T) {
+	next := &fakeEmbedder{}
+	c := newCachedEmbedder(t, next)
+
+	first, err := c.Embed(context.Background(), "hello")
+	if err != nil {
+		t.Fatalf("failed to embed: %v", err)
+	}
+	first[0] = 99
+
+	second, err := c.Embed(context.Background(), "hello")
+	if err != nil {
+		t.Fatalf("failed to embed: %v", err)
+	}
+	if second[0] != 5 {
+		t.Fatalf("expected cached vector [5], got %v", second)
+	}
+	second[0] = 99
+
+	batch, err := c.EmbedBatch(context.Background(), []string{"hello"})
+	if err != nil {
+		t.Fatalf("failed to embed batch: %v", err)
+	}
+	if batch[0][0] != 5 {
+		t.Errorf("expected cached vector [5], got %v", batch[0])
+	}
+}
+
+func TestCachedEmbedderSave(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		model       string
+		ttl         time.Duration
+		expectTexts []string
+	}{
+		{
+			desc:        "restore cache",
+			model:       "model",
+			ttl:         time.Hour,
+			expectTexts: nil,
+		},
+		{
+			desc:        "skip other model",
+			model:       "other",
+			ttl:         time.Hour,
+			expectTexts: []string{"hello", "world"},
+		},
+		{
+			desc:        "skip expired",
+			model:       "model",
+			ttl:         time.Nanosecond,
+			expectTexts: []string{"hello", "world"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "cache.jsonl")
+			c := newCachedEmbedder(t, &fakeEmbedder{}, adapter.WithCacheFile(file), adapter.WithCacheTTL(tc.ttl))
+			for _, q := range []string{"hello", "world"} {
+				if _, err := c.Embed(context.Background(), q); err != nil {
+					t.Fatalf("failed to embed: %v", err)
+				}
+			}
+			time.Sleep(time.Millisecond)
+			if err := c.Save(); err != nil {
+				t.Fatalf("failed to save: %v", err)
+			}
+
+			next := &fakeEmbedder{}
+			restored, err := adapter.NewCachedEmbedder(next, tc.model, 10, adapter.WithCacheFile(file))
+			if err != nil {
+				t.Fatalf("failed to load cache: %v", err)
+			}
+			for _, q := range []string{"hello", "world"} {
+				if _, err := restored.Embed(context.Background(), q); err != nil {
+					t.Fatalf("failed to embed: %v", err)
+				}
+			}
+
+			if !slices.Equal(next.texts, tc.expectTexts) {
+				t.Errorf("expected upstream texts %q, got %q", tc.expectTexts, next.texts)
+			}
+		})
+	}
+}
+
+func TestCachedEmbedderLoad(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Format(time.RFC3339Nano)
+	valid := `{"model":"model","text":"hello","vector":[5],"expires_at":"` + expiresAt + `"}`
+
+	testCases := []struct {
+		desc        string
+		data        *string
+		dir         bool
+		expectErr   bool
+		expectTexts []string
+	}{
+		{
+			desc:        "missing file",
+			expectTexts: []string{"hello"},
+		},
+		{
+			desc:        "skip corrupt lines",
+			data:        ptr("not json\n" + valid + "\n{\"model\":\n"),
+			expectTexts: nil,
+		},
+		{
+			desc:        "empty file",
+			data:        ptr(""),
+			expectTexts: []string{"hello"},
+		},
+		{
+			desc:      "unreadable file",
+			dir:       true,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "cache.jsonl")
+			if tc.data != nil {
+				if err := os.WriteFile(file, []byte(*tc.data), 0o600); err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+			if tc.dir {
+				if err := os.Mkdir(file, 0o755); err != nil {
+					t.Fatalf("failed to create dir: %v", err)
+				}
+			}
+
+			next := &fakeEmbedder{}
+			c, err := adapter.NewCachedEmbedder(next, "model", 10, adapter.WithCacheFile(file))
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load cache: %v", err)
+			}
+
+			if _, err := c.Embed(context.Background(), "hello"); err != nil {
+				t.Fatalf("failed to embed: %v", err)
+			}
+			if !slices.Equal(next.texts, tc.expectTexts) {
+				t.Errorf("expected upstream texts %q, got %q", tc.expectTexts, next.texts)
+			}
+		})
+	}
+}
+
+// 캐시 파일을 설정하지 않으면 저장하지 않는다.
+func TestCachedEmbedderSaveWithoutFile(t *testing.T) {
+	c := newCachedEmbedder(t, &fakeEmbedder{})
+	if err := c.Save(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,110 @@
+package adapter
+
+import (
+	"fmt"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+// 패시지 조건을 Qdrant 조건으로 바꿉니다. filter가 nil이면 nil을 반환합니다.
+func toQdrantFilter(filter *passage.Filter) (*qdrant.Filter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	must, err := toQdrantConditions(filter.Must)
+	if err != nil {
+		return nil, err
+	}
+	should, err := toQdrantConditions(filter.Should)
+	if err != nil {
+		return nil, err
+	}
+	mustNot, err := toQdrantConditions(filter.MustNot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &qdrant.Filter{
+		Must:    must,
+		Should:  should,
+		MustNot: mustNot,
+	}, nil
+}
+
+func toQdrantConditions(conditions []*passage.Condition) ([]*qdrant.Condition, error) {
+	result := make([]*qdrant.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		qc, err := toQdrantCondition(c)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, qc)
+	}
+	return result, nil
+}
+
+func toQdrantCondition(c *passage.Condition) (*qdrant.Condition, error) {
+	if nested, ok := c.Condition.(*passage.Condition_Filter); ok {
+		filter, err := toQdrantFilter(nested.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if filter == nil {
+			return nil, fmt.Errorf("%w: empty nested filter", service.ErrInvalidFilter)
+		}
+		return qdrant.NewFilterAsCondition(filter), nil
+	}
+
+	if c.Field == "" {
+		return nil, fmt.Errorf("%w: field is required", service.ErrInvalidFilter)
+	}
+
+	switch v := c.Condition.(type) {
+	case *passage.Condition_Keyword:
+		return qdrant.NewMatchKeyword(c.Field, v.Keyword), nil
+	case *passage.Condition_Keywords:
+		if len(v.Keywords.GetValues()) == 0 {
+			return nil, fmt.Errorf("%w: keywords of %q are empty", service.ErrInvalidFilter, c.Field)
+		}
+		return qdrant.NewMatchKeywords(c.Field, v.Keywords.Values...), nil
+	case *passage.Condition_Integer:
+		return qdrant.NewMatchInt(c.Field, v.Integer), nil
+	case *passage.Condition_Boolean:
+		return qdrant.NewMatchBool(c.Field, v.Boolean), nil
+	case *passage.Condition_Range:
+		return qdrant.NewRange(c.Field, &qdrant.Range{
+			Gt:  doubleValue(v.Range.GetGt()),
+			Gte: doubleValue(v.Range.GetGte()),
+			Lt:  doubleValue(v.Range.GetLt()),
+			Lte: doubleValue(v.Range.GetLte()),
+		}), nil
+	case *passage.Condition_DatetimeRange:
+		r := v.DatetimeRange
+		for _, t := range []*timestamppb.Timestamp{r.GetGt(), r.GetGte(), r.GetLt(), r.GetLte()} {
+			if t != nil && !t.IsValid() {
+				return nil, fmt.Errorf("%w: invalid datetime range of %q", service.ErrInvalidFilter, c.Field)
+			}
+		}
+		return qdrant.NewDatetimeRange(c.Field, &qdrant.DatetimeRange{
+			Gt:  r.GetGt(),
+			Gte: r.GetGte(),
+			Lt:  r.GetLt(),
+			Lte: r.GetLte(),
+		}), nil
+	default:
+		return nil, fmt.Errorf("%w: condition of %q is not set", service.ErrInvalidFilter, c.Field)
+	}
+}
+
+func doubleValue(v *wrapperspb.DoubleValue) *float64 {
+	if v == nil {
+		return nil
+	}
+	return qdrant.PtrOf(v.Value)
+}
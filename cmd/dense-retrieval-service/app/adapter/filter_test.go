@@ -0,0 +1,157 @@
+package adapter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+func TestToQdrantFilter(t *testing.T) {
+	created := timestamppb.New(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		desc      string
+		filter    *passage.Filter
+		expected  *qdrant.Filter
+		expectErr error
+	}{
+		{
+			desc: "nil filter",
+		},
+		{
+			desc: "keyword conditions",
+			filter: &passage.Filter{
+				Must: []*passage.Condition{
+					{Field: "project", Condition: &passage.Condition_Keyword{Keyword: "AA"}},
+				},
+				Should: []*passage.Condition{
+					{Field: "status", Condition: &passage.Condition_Keywords{Keywords: &passage.Keywords{Values: []string{"Open", "Done"}}}},
+				},
+				MustNot: []*passage.Condition{
+					{Field: "labels", Condition: &passage.Condition_Keyword{Keyword: "wontfix"}},
+				},
+			},
+			expected: &qdrant.Filter{
+				Must:    []*qdrant.Condition{qdrant.NewMatchKeyword("project", "AA")},
+				Should:  []*qdrant.Condition{qdrant.NewMatchKeywords("status", "Open", "Done")},
+				MustNot: []*qdrant.Condition{qdrant.NewMatchKeyword("labels", "wontfix")},
+			},
+		},
+		{
+			desc: "integer and boolean",
+			filter: &passage.Filter{
+				Must: []*passage.Condition{
+					{Field: "chunk", Condition: &passage.Condition_Integer{Integer: 0}},
+					{Field: "resolved", Condition: &passage.Condition_Boolean{Boolean: true}},
+				},
+			},
+			expected: &qdrant.Filter{
+				Must:    []*qdrant.Condition{qdrant.NewMatchInt("chunk", 0), qdrant.NewMatchBool("resolved", true)},
+				Should:  []*qdrant.Condition{},
+				MustNot: []*qdrant.Condition{},
+			},
+		},
+		{
+			desc: "ranges keep unset bounds open",
+			filter: &passage.Filter{
+				Must: []*passage.Condition{
+					{Field: "chunk", Condition: &passage.Condition_Range{Range: &passage.Range{Gte: wrapperspb.Double(1), Lt: wrapperspb.Double(3)}}},
+					{Field: "created", Condition: &passage.Condition_DatetimeRange{DatetimeRange: &passage.DatetimeRange{Gte: created}}},
+				},
+			},
+			expected: &qdrant.Filter{
+				Must: []*qdrant.Condition{
+					qdrant.NewRange("chunk", &qdrant.Range{Gte: qdrant.PtrOf(1.0), Lt: qdrant.PtrOf(3.0)}),
+					qdrant.NewDatetimeRange("created", &qdrant.DatetimeRange{Gte: created}),
+				},
+				Should:  []*qdrant.Condition{},
+				MustNot: []*qdrant.Condition{},
+			},
+		},
+		{
+			desc: "nested filter",
+			filter: &passage.Filter{
+				Must: []*passage.Condition{
+					{Condition: &passage.Condition_Filter{Filter: &passage.Filter{
+						Should: []*passage.Condition{
+							{Field: "labels", Condition: &passage.Condition_Keyword{Keyword: "backend"}},
+						},
+					}}},
+				},
+			},
+			expected: &qdrant.Filter{
+				Must: []*qdrant.Condition{qdrant.NewFilterAsCondition(&qdrant.Filter{
+					Must:    []*qdrant.Condition{},
+					Should:  []*qdrant.Condition{qdrant.NewMatchKeyword("labels", "backend")},
+					MustNot: []*qdrant.Condition{},
+				})},
+				Should:  []*qdrant.Condition{},
+				MustNot: []*qdrant.Condition{},
+			},
+		},
+		{
+			desc: "empty nested filter",
+			filter: &passage.Filter{
+				Must: []*passage.Condition{{Condition: &passage.Condition_Filter{}}},
+			},
+			expectErr: service.ErrInvalidFilter,
+		},
+		{
+			desc: "missing field",
+			filter: &passage.Filter{
+				Must: []*passage.Condition{{Condition: &passage.Condition_Keyword{Keyword: "AA"}}},
+			},
+			expectErr: service.ErrInvalidFilter,
+		},
+		{
+			desc: "empty keywords",
+			filter: &passage.Filter{
+				Should: []*passage.Condition{{Field: "status", Condition: &passage.Condition_Keywords{Keywords: &passage.Keywords{}}}},
+			},
+			expectErr: service.ErrInvalidFilter,
+		},
+		{
+			desc: "invalid datetime",
+			filter: &passage.Filter{
+				MustNot: []*passage.Condition{
+					{Field: "updated", Condition: &passage.Condition_DatetimeRange{DatetimeRange: &passage.DatetimeRange{Lt: &timestamppb.Timestamp{Nanos: -1}}}},
+				},
+			},
+			expectErr: service.ErrInvalidFilter,
+		},
+		{
+			desc: "condition not set",
+			filter: &passage.Filter{
+				Must: []*passage.Condition{{Field: "project"}},
+			},
+			expectErr: service.ErrInvalidFilter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := toQdrantFilter(tc.filter)
+			if tc.expectErr != nil {
+				if !errors.Is(err, tc.expectErr) {
+					t.Fatalf("expected %v, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to convert filter: %v", err)
+			}
+
+			if !proto.Equal(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -2,25 +2,30 @@ package adapter
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 
 	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
 )
 
 var _ service.Embedder = (*OpenAIClient)(nil)
 
 type OpenAIClient struct {
 	client *openai.Client
+	// 임베딩 모델 이름. llama.cpp 서버처럼 모델이 하나인 서버는 비워 둘 수 있습니다.
+	model openai.EmbeddingModel
 }
 
-func NewOpenAIClient(baseURL string) *OpenAIClient {
-	client := openai.NewClient(
-		option.WithBaseURL(baseURL),
-	)
+// opts로 API 키, 요청 기한, 재시도 횟수 같은 요청 옵션을 추가할 수 있습니다.
+func NewOpenAIClient(baseURL, model string, opts ...option.RequestOption) *OpenAIClient {
+	client := openai.NewClient(append([]option.RequestOption{option.WithBaseURL(baseURL)}, opts...)...)
 
-	return &OpenAIClient{client: &client}
+	return &OpenAIClient{client: &client, model: openai.EmbeddingModel(model)}
 }
 
 func (o *OpenAIClient) Embed(ctx context.Context, text string) ([]float32, error) {
@@ -28,14 +33,73 @@ func (o *OpenAIClient) Embed(ctx context.Context, text string) ([]float32, error
 		Input: openai.EmbeddingNewParamsInputUnion{
 			OfString: openai.String(text),
 		},
+		Model:          o.model,
 		EncodingFormat: openai.EmbeddingNewParamsEncodingFormatFloat,
 	})
 	if err != nil {
-		return nil, err
+		return nil, embedError(err)
 	}
-	vectors := make([]float32, len(resp.Data[0].Embedding))
-	for i, v := range resp.Data[0].Embedding {
-		vectors[i] = float32(v)
+	if len(resp.Data) == 0 {
+		return nil, errors.New("no embeddings returned")
+	}
+	return convert(resp.Data[0].Embedding), nil
+}
+
+// 짧은 텍스트를 임베딩해서 임베딩 서버가 응답하는지 확인합니다.
+func (o *OpenAIClient) Check(ctx context.Context) error {
+	_, err := o.Embed(ctx, "ping")
+	return err
+}
+
+func (o *OpenAIClient) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	resp, err := o.client.Embeddings.New(ctx, openai.EmbeddingNewParams{
+		Input: openai.EmbeddingNewParamsInputUnion{
+			OfArrayOfStrings: texts,
+		},
+		Model:          o.model,
+		EncodingFormat: openai.EmbeddingNewParamsEncodingFormatFloat,
+	})
+	if err != nil {
+		return nil, embedError(err)
+	}
+
+	// 응답 순서가 입력 순서와 다를 수 있으므로 index로 자리를 찾는다.
+	vectors := make([][]float32, len(texts))
+	for _, data := range resp.Data {
+		if data.Index < 0 || int(data.Index) >= len(texts) {
+			return nil, fmt.Errorf("unexpected embedding index %d", data.Index)
+		}
+		vectors[data.Index] = convert(data.Embedding)
+	}
+	for i, v := range vectors {
+		if v == nil {
+			return nil, fmt.Errorf("no embedding returned for input %d", i)
+		}
 	}
 	return vectors, nil
 }
+
+func convert(in []float64) []float32 {
+	out := make([]float32, len(in))
+	for i, v := range in {
+		out[i] = float32(v)
+	}
+	return out
+}
+
+// 임베딩 서버가 과부하 상태이거나 응답하지 않으면 retrieval.UnavailableError를 반환합니다.
+func embedError(err error) error {
+	var apiErr *openai.Error
+	if errors.As(err, &apiErr) {
+		if apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError {
+			return &retrieval.UnavailableError{Service: "embedder", Err: err}
+		}
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	// 응답을 받지 못한 오류는 연결 문제로 본다.
+	return &retrieval.UnavailableError{Service: "embedder", Err: err}
+}
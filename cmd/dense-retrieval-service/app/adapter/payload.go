@@ -0,0 +1,53 @@
+package adapter
+
+import (
+	"strconv"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// 포인트 ID를 문자열로 바꿉니다. UUID는 그대로, 숫자 ID는 10진수 문자열로 바꿉니다.
+func pointID(id *qdrant.PointId) string {
+	switch v := id.GetPointIdOptions().(type) {
+	case *qdrant.PointId_Uuid:
+		return v.Uuid
+	case *qdrant.PointId_Num:
+		return strconv.FormatUint(v.Num, 10)
+	default:
+		return ""
+	}
+}
+
+// Qdrant payload를 protobuf Struct 필드로 바꿉니다.
+func toStructFields(payload map[string]*qdrant.Value) map[string]*structpb.Value {
+	fields := make(map[string]*structpb.Value, len(payload))
+	for k, v := range payload {
+		fields[k] = toStructValue(v)
+	}
+	return fields
+}
+
+// Qdrant 값을 protobuf 값으로 바꿉니다. 정수는 JSON과 같이 숫자 값으로 바꿉니다.
+func toStructValue(v *qdrant.Value) *structpb.Value {
+	switch kind := v.GetKind().(type) {
+	case *qdrant.Value_StringValue:
+		return structpb.NewStringValue(kind.StringValue)
+	case *qdrant.Value_IntegerValue:
+		return structpb.NewNumberValue(float64(kind.IntegerValue))
+	case *qdrant.Value_DoubleValue:
+		return structpb.NewNumberValue(kind.DoubleValue)
+	case *qdrant.Value_BoolValue:
+		return structpb.NewBoolValue(kind.BoolValue)
+	case *qdrant.Value_StructValue:
+		return structpb.NewStructValue(&structpb.Struct{Fields: toStructFields(kind.StructValue.GetFields())})
+	case *qdrant.Value_ListValue:
+		values := make([]*structpb.Value, 0, len(kind.ListValue.GetValues()))
+		for _, item := range kind.ListValue.GetValues() {
+			values = append(values, toStructValue(item))
+		}
+		return structpb.NewListValue(&structpb.ListValue{Values: values})
+	default:
+		return structpb.NewNullValue()
+	}
+}
@@ -3,54 +3,268 @@ package adapter
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 
 	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
 )
 
 var _ service.VectorRetriever = (*QdrantClient)(nil)
 
+type qdrantOptions struct {
+	// 밀집 벡터 이름. 비어 있으면 컬렉션의 기본 벡터를 사용합니다.
+	denseVector string
+	// 희소 벡터 이름.
+	sparseVector string
+	// 쿼리 원문으로 희소 벡터를 만드는 Qdrant 추론 모델. 색인할 때와 같은 모델이어야 합니다.
+	sparseModel string
+	// 하이브리드 검색에서 두 방식의 결과를 합치는 방식.
+	fusion qdrant.Fusion
+	// 하이브리드 검색에서 방식마다 가져오는 후보 수. 요청한 결과 수의 배수입니다.
+	prefetchFactor int
+}
+
+var defaultQdrantOptions = qdrantOptions{
+	sparseVector:   "bm25",
+	sparseModel:    "qdrant/bm25",
+	fusion:         qdrant.Fusion_RRF,
+	prefetchFactor: 2,
+}
+
+type QdrantOption func(*qdrantOptions)
+
+func WithDenseVector(name string) QdrantOption {
+	return func(opt *qdrantOptions) {
+		opt.denseVector = name
+	}
+}
+
+func WithSparseVector(name, model string) QdrantOption {
+	return func(opt *qdrantOptions) {
+		opt.sparseVector = name
+		opt.sparseModel = model
+	}
+}
+
+func WithFusion(fusion qdrant.Fusion) QdrantOption {
+	return func(opt *qdrantOptions) {
+		opt.fusion = fusion
+	}
+}
+
+func WithPrefetchFactor(factor int) QdrantOption {
+	return func(opt *qdrantOptions) {
+		opt.prefetchFactor = factor
+	}
+}
+
 type QdrantClient struct {
 	client *qdrant.Client
+	// 패시지를 저장한 컬렉션 이름.
+	collection string
+	options    *qdrantOptions
+	// 컬렉션에 희소 벡터가 없으면 참. 시작할 때 CheckSparseVector가 설정합니다.
+	sparseMissing bool
 }
 
-func NewQdrantClient(host string) (*QdrantClient, error) {
-	client, err := qdrant.NewClient(&qdrant.Config{
-		Host: host,
-	})
+func NewQdrantClient(config *qdrant.Config, collection string, opts ...QdrantOption) (*QdrantClient, error) {
+	options := defaultQdrantOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	client, err := qdrant.NewClient(config)
 	if err != nil {
 		return nil, err
 	}
 
-	return &QdrantClient{client: client}, nil
+	return &QdrantClient{client: client, collection: collection, options: &options}, nil
+}
+
+// Qdrant에 연결할 수 있고 패시지 컬렉션이 있는지 확인합니다.
+func (q *QdrantClient) Check(ctx context.Context) error {
+	ok, err := q.client.CollectionExists(ctx, q.collection)
+	if err != nil {
+		return qdrantError(err)
+	}
+	if !ok {
+		return fmt.Errorf("collection %q does not exist", q.collection)
+	}
+	return nil
+}
+
+// 컬렉션에 희소 벡터가 있는지 확인합니다. 서버를 시작하기 전에 호출합니다.
+// 희소 벡터가 없으면 이후의 희소 벡터 검색과 하이브리드 검색에 service.ErrUnsupportedMode를 반환하고, 이 오류를 감싼 오류를 반환합니다.
+func (q *QdrantClient) CheckSparseVector(ctx context.Context) error {
+	info, err := q.client.GetCollectionInfo(ctx, q.collection)
+	if err != nil {
+		return qdrantError(err)
+	}
+
+	_, ok := info.GetConfig().GetParams().GetSparseVectorsConfig().GetMap()[q.options.sparseVector]
+	q.sparseMissing = !ok
+	if !ok {
+		return fmt.Errorf("%w: collection %q has no sparse vector %q", service.ErrUnsupportedMode, q.collection, q.options.sparseVector)
+	}
+	return nil
 }
 
 func (q *QdrantClient) Retrieve(ctx context.Context, params service.RetrieveParams) ([]service.RetrieveResult, error) {
-	limit := uint64(params.Limit)
-	resp, err := q.client.Query(ctx, &qdrant.QueryPoints{
-		CollectionName: "content",
-		Query:          qdrant.NewQuery(params.Vectors...),
-		Limit:          &limit,
-		WithPayload:    qdrant.NewWithPayload(true),
+	query, err := q.toQueryPoints(params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := q.client.Query(ctx, query)
+	if err != nil {
+		return nil, qdrantError(err)
+	}
+
+	return toRetrieveResults(resp), nil
+}
+
+// 조건이 잘못된 쿼리는 해당 결과의 Err에 담고, 나머지 쿼리만 한 번의 요청으로 검색합니다.
+func (q *QdrantClient) RetrieveBatch(ctx context.Context, params []service.RetrieveParams) ([]service.RetrieveBatchResult, error) {
+	results := make([]service.RetrieveBatchResult, len(params))
+	queries := make([]*qdrant.QueryPoints, 0, len(params))
+	indexes := make([]int, 0, len(params))
+	for i, p := range params {
+		query, err := q.toQueryPoints(p)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		queries = append(queries, query)
+		indexes = append(indexes, i)
+	}
+	if len(queries) == 0 {
+		return results, nil
+	}
+
+	resp, err := q.client.QueryBatch(ctx, &qdrant.QueryBatchPoints{
+		CollectionName: q.collection,
+		QueryPoints:    queries,
 	})
+	if err != nil {
+		return nil, qdrantError(err)
+	}
+	if len(resp) != len(queries) {
+		return nil, fmt.Errorf("unexpected batch result count: got %d, want %d", len(resp), len(queries))
+	}
+
+	for i, r := range resp {
+		results[indexes[i]].Results = toRetrieveResults(r.GetResult())
+	}
+
+	return results, nil
+}
+
+// Qdrant에 연결할 수 없거나 Qdrant가 과부하 상태이면 retrieval.UnavailableError를 반환합니다.
+// 그 밖의 오류는 Qdrant의 상태 코드가 클라이언트에 그대로 전달되지 않도록 메시지만 남깁니다.
+func qdrantError(err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return &retrieval.UnavailableError{Service: "qdrant", Err: err}
+	case codes.Canceled, codes.DeadlineExceeded:
+		return err
+	default:
+		return fmt.Errorf("qdrant: %s", st.Message())
+	}
+}
+
+// 검색 방식에 맞는 쿼리를 만듭니다.
+// 컬렉션에 희소 벡터가 없으면 희소 벡터 검색과 하이브리드 검색에 service.ErrUnsupportedMode를 감싼 오류를 반환합니다.
+// 하이브리드 검색은 밀집 벡터와 희소 벡터로 후보를 각각 가져와서 Qdrant에서 순위를 합칩니다.
+func (q *QdrantClient) toQueryPoints(params service.RetrieveParams) (*qdrant.QueryPoints, error) {
+	filter, err := toQdrantFilter(params.Filter)
 	if err != nil {
 		return nil, err
 	}
 
-	results := make([]service.RetrieveResult, 0, len(resp))
-	for _, point := range resp {
+	limit := uint64(params.Limit)
+	query := &qdrant.QueryPoints{
+		CollectionName: q.collection,
+		Filter:         filter,
+		Limit:          &limit,
+		WithPayload:    qdrant.NewWithPayload(true),
+	}
+	if params.Offset > 0 {
+		query.Offset = qdrant.PtrOf(uint64(params.Offset))
+	}
+
+	needsSparse := params.Mode == passage.RetrievalMode_RETRIEVAL_MODE_SPARSE || params.Mode == passage.RetrievalMode_RETRIEVAL_MODE_HYBRID
+	if needsSparse && q.sparseMissing {
+		return nil, fmt.Errorf("%w: collection %q has no sparse vector %q", service.ErrUnsupportedMode, q.collection, q.options.sparseVector)
+	}
+
+	switch params.Mode {
+	case passage.RetrievalMode_RETRIEVAL_MODE_SPARSE:
+		query.Query = q.sparseQuery(params.Query)
+		query.Using = qdrant.PtrOf(q.options.sparseVector)
+	case passage.RetrievalMode_RETRIEVAL_MODE_HYBRID:
+		// 건너뛸 상위 결과도 합친 순위에 들어가야 하므로 후보 수는 offset을 포함해서 정한다.
+		candidates := uint64(params.Offset+params.Limit) * uint64(q.options.prefetchFactor)
+		query.Prefetch = []*qdrant.PrefetchQuery{
+			{
+				Query:  qdrant.NewQueryDense(params.Vectors),
+				Using:  q.denseVector(),
+				Filter: filter,
+				Limit:  &candidates,
+			},
+			{
+				Query:  q.sparseQuery(params.Query),
+				Using:  qdrant.PtrOf(q.options.sparseVector),
+				Filter: filter,
+				Limit:  &candidates,
+			},
+		}
+		query.Query = qdrant.NewQueryFusion(q.options.fusion)
+	default:
+		query.Query = qdrant.NewQueryDense(params.Vectors)
+		query.Using = q.denseVector()
+	}
+
+	return query, nil
+}
+
+// 쿼리 원문을 Qdrant가 희소 벡터로 바꿔 검색하는 쿼리.
+func (q *QdrantClient) sparseQuery(text string) *qdrant.Query {
+	return qdrant.NewQueryNearest(qdrant.NewVectorInputDocument(&qdrant.Document{
+		Text:  text,
+		Model: q.options.sparseModel,
+	}))
+}
+
+// 밀집 벡터 이름. 컬렉션의 기본 벡터를 사용하면 nil입니다.
+func (q *QdrantClient) denseVector() *string {
+	if q.options.denseVector == "" {
+		return nil
+	}
+	return qdrant.PtrOf(q.options.denseVector)
+}
+
+func toRetrieveResults(points []*qdrant.ScoredPoint) []service.RetrieveResult {
+	results := make([]service.RetrieveResult, 0, len(points))
+	for _, point := range points {
 		data, err := json.Marshal(point.Payload)
 		if err != nil {
 			slog.Warn("failed to marshal payload", "error", err)
 			continue
 		}
 		results = append(results, service.RetrieveResult{
+			ID:      pointID(point.Id),
 			Score:   point.Score,
+			Payload: toStructFields(point.Payload),
 			Passage: data,
 		})
 	}
 
-	return results, nil
+	return results
 }
@@ -0,0 +1,256 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+func TestToQueryPoints(t *testing.T) {
+	vectors := []float32{0.1, 0.2}
+	keyword := &passage.Filter{
+		Must: []*passage.Condition{{Field: "project", Condition: &passage.Condition_Keyword{Keyword: "AA"}}},
+	}
+	qdrantKeyword := &qdrant.Filter{
+		Must:    []*qdrant.Condition{qdrant.NewMatchKeyword("project", "AA")},
+		Should:  []*qdrant.Condition{},
+		MustNot: []*qdrant.Condition{},
+	}
+	bm25 := qdrant.NewQueryNearest(qdrant.NewVectorInputDocument(&qdrant.Document{Text: "query", Model: "qdrant/bm25"}))
+
+	testCases := []struct {
+		desc          string
+		opts          []QdrantOption
+		sparseMissing bool
+		params        service.RetrieveParams
+		expected      *qdrant.QueryPoints
+		expectErr     error
+	}{
+		{
+			desc:   "dense with default vector",
+			params: service.RetrieveParams{Vectors: vectors, Query: "query", Limit: 5},
+			expected: &qdrant.QueryPoints{
+				Query: qdrant.NewQueryDense(vectors),
+				Limit: qdrant.PtrOf(uint64(5)),
+			},
+		},
+		{
+			desc:   "dense with named vector and offset",
+			opts:   []QdrantOption{WithDenseVector("dense")},
+			params: service.RetrieveParams{Mode: passage.RetrievalMode_RETRIEVAL_MODE_DENSE, Vectors: vectors, Limit: 5, Offset: 10, Filter: keyword},
+			expected: &qdrant.QueryPoints{
+				Query:  qdrant.NewQueryDense(vectors),
+				Using:  qdrant.PtrOf("dense"),
+				Filter: qdrantKeyword,
+				Limit:  qdrant.PtrOf(uint64(5)),
+				Offset: qdrant.PtrOf(uint64(10)),
+			},
+		},
+		{
+			desc:   "sparse uses query text",
+			params: service.RetrieveParams{Mode: passage.RetrievalMode_RETRIEVAL_MODE_SPARSE, Query: "query", Limit: 5},
+			expected: &qdrant.QueryPoints{
+				Query: bm25,
+				Using: qdrant.PtrOf("bm25"),
+				Limit: qdrant.PtrOf(uint64(5)),
+			},
+		},
+		{
+			desc: "hybrid prefetches both vectors",
+			opts: []QdrantOption{WithPrefetchFactor(3), WithFusion(qdrant.Fusion_DBSF)},
+			params: service.RetrieveParams{
+				Mode:    passage.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+				Vectors: vectors,
+				Query:   "query",
+				Limit:   5,
+				Offset:  5,
+				Filter:  keyword,
+			},
+			expected: &qdrant.QueryPoints{
+				Query: qdrant.NewQueryFusion(qdrant.Fusion_DBSF),
+				Prefetch: []*qdrant.PrefetchQuery{
+					{
+						Query:  qdrant.NewQueryDense(vectors),
+						Filter: qdrantKeyword,
+						// (offset + limit) * prefetch factor
+						Limit: qdrant.PtrOf(uint64(30)),
+					},
+					{
+						Query:  bm25,
+						Using:  qdrant.PtrOf("bm25"),
+						Filter: qdrantKeyword,
+						Limit:  qdrant.PtrOf(uint64(30)),
+					},
+				},
+				Filter: qdrantKeyword,
+				Limit:  qdrant.PtrOf(uint64(5)),
+				Offset: qdrant.PtrOf(uint64(5)),
+			},
+		},
+		{
+			desc: "hybrid with named vectors",
+			opts: []QdrantOption{WithDenseVector("dense"), WithSparseVector("text", "custom/bm25")},
+			params: service.RetrieveParams{
+				Mode:    passage.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+				Vectors: vectors,
+				Query:   "query",
+				Limit:   4,
+			},
+			expected: &qdrant.QueryPoints{
+				Query: qdrant.NewQueryFusion(qdrant.Fusion_RRF),
+				Prefetch: []*qdrant.PrefetchQuery{
+					{
+						Query: qdrant.NewQueryDense(vectors),
+						Using: qdrant.PtrOf("dense"),
+						Limit: qdrant.PtrOf(uint64(8)),
+					},
+					{
+						Query: qdrant.NewQueryNearest(qdrant.NewVectorInputDocument(&qdrant.Document{Text: "query", Model: "custom/bm25"})),
+						Using: qdrant.PtrOf("text"),
+						Limit: qdrant.PtrOf(uint64(8)),
+					},
+				},
+				Limit: qdrant.PtrOf(uint64(4)),
+			},
+		},
+		{
+			desc:          "dense without sparse vector",
+			sparseMissing: true,
+			params:        service.RetrieveParams{Vectors: vectors, Limit: 5},
+			expected: &qdrant.QueryPoints{
+				Query: qdrant.NewQueryDense(vectors),
+				Limit: qdrant.PtrOf(uint64(5)),
+			},
+		},
+		{
+			desc:          "sparse without sparse vector",
+			sparseMissing: true,
+			params:        service.RetrieveParams{Mode: passage.RetrievalMode_RETRIEVAL_MODE_SPARSE, Query: "query", Limit: 5},
+			expectErr:     service.ErrUnsupportedMode,
+		},
+		{
+			desc:          "hybrid without sparse vector",
+			sparseMissing: true,
+			params:        service.RetrieveParams{Mode: passage.RetrievalMode_RETRIEVAL_MODE_HYBRID, Vectors: vectors, Query: "query", Limit: 5},
+			expectErr:     service.ErrUnsupportedMode,
+		},
+		{
+			desc: "invalid filter",
+			params: service.RetrieveParams{
+				Vectors: vectors,
+				Limit:   5,
+				Filter:  &passage.Filter{Must: []*passage.Condition{{Field: "project"}}},
+			},
+			expectErr: service.ErrInvalidFilter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			options := defaultQdrantOptions
+			for _, opt := range tc.opts {
+				opt(&options)
+			}
+			q := &QdrantClient{collection: "content", options: &options, sparseMissing: tc.sparseMissing}
+
+			got, err := q.toQueryPoints(tc.params)
+			if tc.expectErr != nil {
+				if !errors.Is(err, tc.expectErr) {
+					t.Fatalf("expected %v, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to build query: %v", err)
+			}
+
+			tc.expected.CollectionName = "content"
+			tc.expected.WithPayload = qdrant.NewWithPayload(true)
+			if !proto.Equal(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// 컬렉션 정보를 반환하는 Qdrant 컬렉션 서버.
+type fakeCollections struct {
+	qdrant.UnimplementedCollectionsServer
+
+	sparseVectors map[string]*qdrant.SparseVectorParams
+}
+
+func (f *fakeCollections) Get(ctx context.Context, req *qdrant.GetCollectionInfoRequest) (*qdrant.GetCollectionInfoResponse, error) {
+	return &qdrant.GetCollectionInfoResponse{
+		Result: &qdrant.CollectionInfo{
+			Config: &qdrant.CollectionConfig{
+				Params: &qdrant.CollectionParams{
+					SparseVectorsConfig: qdrant.NewSparseVectorsConfig(f.sparseVectors),
+				},
+			},
+		},
+	}, nil
+}
+
+func TestCheckSparseVector(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		sparseVectors map[string]*qdrant.SparseVectorParams
+		expectErr     error
+	}{
+		{
+			desc:          "sparse vector exists",
+			sparseVectors: map[string]*qdrant.SparseVectorParams{"bm25": {Modifier: qdrant.Modifier_Idf.Enum()}},
+		},
+		{
+			desc:      "no sparse vectors",
+			expectErr: service.ErrUnsupportedMode,
+		},
+		{
+			desc:          "other sparse vector",
+			sparseVectors: map[string]*qdrant.SparseVectorParams{"splade": {}},
+			expectErr:     service.ErrUnsupportedMode,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("failed to listen: %v", err)
+			}
+			s := grpc.NewServer()
+			qdrant.RegisterCollectionsServer(s, &fakeCollections{sparseVectors: tc.sparseVectors})
+			go func() { _ = s.Serve(listener) }()
+			t.Cleanup(s.Stop)
+
+			q, err := NewQdrantClient(&qdrant.Config{
+				Host:                   "127.0.0.1",
+				Port:                   listener.Addr().(*net.TCPAddr).Port,
+				SkipCompatibilityCheck: true,
+			}, "content")
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			err = q.CheckSparseVector(context.Background())
+			if !errors.Is(err, tc.expectErr) {
+				t.Fatalf("expected %v, got %v", tc.expectErr, err)
+			}
+
+			// 희소 벡터가 없으면 이후의 희소 벡터 검색을 거절한다.
+			_, err = q.toQueryPoints(service.RetrieveParams{Mode: passage.RetrievalMode_RETRIEVAL_MODE_SPARSE, Query: "query", Limit: 1})
+			if !errors.Is(err, tc.expectErr) {
+				t.Errorf("expected sparse query error %v, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/pkg/retry"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+)
+
+var _ service.Reranker = (*RerankClient)(nil)
+
+// llama.cpp 서버의 /v1/rerank API로 순위를 다시 매기는 cross-encoder 클라이언트.
+// Qwen3-Reranker, bge-reranker처럼 --reranking 옵션으로 띄운 모델을 사용합니다.
+type RerankClient struct {
+	client *http.Client
+
+	// API 기본 URL. e.g., "http://localhost:8081/v1"
+	baseURL string
+	// 모델 이름. llama.cpp 서버처럼 모델이 하나인 서버는 비워 둘 수 있습니다.
+	model  string
+	apiKey string
+}
+
+func NewRerankClient(baseURL, model, apiKey string, timeout time.Duration) *RerankClient {
+	return &RerankClient{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		model:   model,
+		apiKey:  apiKey,
+	}
+}
+
+type rerankRequest struct {
+	Model     string   `json:"model,omitempty"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+	TopN      int      `json:"top_n"`
+}
+
+type rerankResponse struct {
+	Results []struct {
+		Index          int     `json:"index"`
+		RelevanceScore float64 `json:"relevance_score"`
+	} `json:"results"`
+}
+
+func (c *RerankClient) Rerank(ctx context.Context, query string, documents []string) ([]float32, error) {
+	if len(documents) == 0 {
+		return nil, nil
+	}
+
+	body, err := json.Marshal(rerankRequest{
+		Model:     c.model,
+		Query:     query,
+		Documents: documents,
+		TopN:      len(documents),
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/rerank", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		return nil, &retrieval.UnavailableError{Service: "reranker", Err: err}
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Warn("failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return nil, &retrieval.UnavailableError{
+			Service:    "reranker",
+			RetryDelay: retry.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Err:        fmt.Errorf("reranker: %s", resp.Status),
+		}
+	default:
+		return nil, fmt.Errorf("reranker: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result rerankResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	// 응답은 관련도 순으로 정렬되어 있으므로 index로 입력 순서를 되찾는다.
+	scores := make([]float32, len(documents))
+	seen := make([]bool, len(documents))
+	for _, r := range result.Results {
+		if r.Index < 0 || r.Index >= len(documents) {
+			return nil, fmt.Errorf("unexpected rerank index %d", r.Index)
+		}
+		scores[r.Index] = float32(r.RelevanceScore)
+		seen[r.Index] = true
+	}
+	for i, ok := range seen {
+		if !ok {
+			return nil, fmt.Errorf("no rerank score returned for document %d", i)
+		}
+	}
+	return scores, nil
+}
+
+// 짧은 문서 하나의 순위를 매겨서 reranker 서버가 응답하는지 확인합니다.
+func (c *RerankClient) Check(ctx context.Context) error {
+	_, err := c.Rerank(ctx, "ping", []string{"pong"})
+	return err
+}
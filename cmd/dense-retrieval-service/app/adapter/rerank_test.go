@@ -0,0 +1,119 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/adapter"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+)
+
+func TestRerankClientRerank(t *testing.T) {
+	testCases := []struct {
+		desc              string
+		status            int
+		response          string
+		expected          []float32
+		expectErr         string
+		expectUnavailable bool
+	}{
+		{
+			desc:     "results sorted by relevance",
+			status:   http.StatusOK,
+			response: `{"results":[{"index":2,"relevance_score":0.9},{"index":0,"relevance_score":0.5},{"index":1,"relevance_score":0.1}]}`,
+			expected: []float32{0.5, 0.1, 0.9},
+		},
+		{
+			desc:      "missing index",
+			status:    http.StatusOK,
+			response:  `{"results":[{"index":2,"relevance_score":0.9},{"index":0,"relevance_score":0.5}]}`,
+			expectErr: "no rerank score returned for document 1",
+		},
+		{
+			desc:      "index out of range",
+			status:    http.StatusOK,
+			response:  `{"results":[{"index":3,"relevance_score":0.9}]}`,
+			expectErr: "unexpected rerank index 3",
+		},
+		{
+			desc:              "server overloaded",
+			status:            http.StatusServiceUnavailable,
+			expectErr:         "503",
+			expectUnavailable: true,
+		},
+		{
+			desc:      "bad request",
+			status:    http.StatusBadRequest,
+			expectErr: "400",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got struct {
+				Model     string   `json:"model"`
+				Query     string   `json:"query"`
+				Documents []string `json:"documents"`
+				TopN      int      `json:"top_n"`
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/rerank" {
+					t.Errorf("expected path /v1/rerank, got %s", r.URL.Path)
+				}
+				if auth := r.Header.Get("Authorization"); auth != "Bearer key" {
+					t.Errorf("expected bearer token, got %q", auth)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("failed to decode request: %v", err)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			t.Cleanup(server.Close)
+
+			client := adapter.NewRerankClient(server.URL+"/v1/", "reranker", "key", time.Second)
+			documents := []string{"a", "b", "c"}
+			scores, err := client.Rerank(context.Background(), "query", documents)
+
+			if got.Query != "query" || !slices.Equal(got.Documents, documents) || got.TopN != len(documents) || got.Model != "reranker" {
+				t.Errorf("unexpected request: %+v", got)
+			}
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+				var unavailable *retrieval.UnavailableError
+				if errors.As(err, &unavailable) != tc.expectUnavailable {
+					t.Errorf("expected unavailable %v, got %v", tc.expectUnavailable, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to rerank: %v", err)
+			}
+			if !slices.Equal(scores, tc.expected) {
+				t.Errorf("expected scores %v, got %v", tc.expected, scores)
+			}
+		})
+	}
+}
+
+// 순위를 매길 문서가 없으면 요청하지 않는다.
+func TestRerankClientRerankEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no request")
+	}))
+	t.Cleanup(server.Close)
+
+	scores, err := adapter.NewRerankClient(server.URL, "", "", time.Second).Rerank(context.Background(), "query", nil)
+	if err != nil || scores != nil {
+		t.Errorf("expected nil scores and error, got %v, %v", scores, err)
+	}
+}
@@ -3,36 +3,136 @@ package app
 import (
 	"context"
 	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+
+	"github.com/openai/openai-go/option"
+	"github.com/qdrant/go-client/qdrant"
 
 	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/adapter"
 	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/pkg/service/interceptor"
 	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/server"
+	"github.com/devafterdark/project-lumos/pkg/service/vars"
 )
 
-func Run() error {
-	qdrantHost, ok := os.LookupEnv("QDRANT_HOST")
-	if !ok {
-		return errors.New("QDRANT_HOST is not set")
+// 시작할 때 Qdrant 컬렉션을 확인하는 최대 시간.
+const startupCheckTimeout = 10 * time.Second
+
+func Run(cfg *Config) error {
+	qdrantConfig := &qdrant.Config{
+		Host:   cfg.Qdrant.Host,
+		Port:   cfg.Qdrant.Port,
+		APIKey: cfg.Qdrant.APIKey,
+		UseTLS: cfg.Qdrant.TLS,
 	}
-	retriever, err := adapter.NewQdrantClient(qdrantHost)
+	retriever, err := adapter.NewQdrantClient(qdrantConfig, cfg.Qdrant.Collection,
+		adapter.WithDenseVector(cfg.Qdrant.DenseVector),
+		adapter.WithSparseVector(cfg.Qdrant.SparseVector, cfg.Qdrant.SparseModel),
+		adapter.WithFusion(fusions[cfg.Retrieval.Fusion]),
+		adapter.WithPrefetchFactor(cfg.Retrieval.PrefetchFactor),
+	)
 	if err != nil {
 		return err
 	}
-	embeddingURL, ok := os.LookupEnv("EMBEDDING_API_URL")
-	if !ok {
-		return errors.New("EMBEDDING_API_URL is not set")
+	// 희소 벡터 없이 색인한 컬렉션이면 희소 벡터와 하이브리드 검색을 사용할 수 없다.
+	checkCtx, cancelCheck := context.WithTimeout(context.Background(), startupCheckTimeout)
+	err = retriever.CheckSparseVector(checkCtx)
+	cancelCheck()
+	if errors.Is(err, service.ErrUnsupportedMode) && cfg.Retrieval.Mode != "dense" {
+		return fmt.Errorf("retrieval.mode %s: %w", cfg.Retrieval.Mode, err)
+	} else if err != nil {
+		slog.Warn("sparse and hybrid retrieval are unavailable", slog.Any("error", err))
 	}
-	embedder := adapter.NewOpenAIClient(embeddingURL)
 
-	svc := service.NewService(retriever, embedder)
+	embedOpts := []option.RequestOption{
+		option.WithRequestTimeout(cfg.Embedding.Timeout),
+		option.WithMaxRetries(cfg.Embedding.MaxRetries),
+	}
+	if cfg.Embedding.APIKey != "" {
+		embedOpts = append(embedOpts, option.WithAPIKey(cfg.Embedding.APIKey))
+	}
+	embedder := adapter.NewOpenAIClient(cfg.Embedding.URL, cfg.Embedding.Model, embedOpts...)
 
-	s := server.NewServer(
-		server.WithServiceV1(svc),
+	var queryEmbedder service.Embedder = embedder
+	if cfg.Embedding.CacheSize > 0 {
+		cached, err := adapter.NewCachedEmbedder(embedder, cfg.Embedding.Model, cfg.Embedding.CacheSize,
+			adapter.WithCacheTTL(cfg.Embedding.CacheTTL),
+			adapter.WithCacheFile(cfg.Embedding.CacheFile),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			cached.LogSummary()
+			if err := cached.Save(); err != nil {
+				slog.Warn("failed to save embedding cache", slog.Any("error", err))
+			}
+		}()
+		expvar.Publish("embedding_cache", cached)
+		queryEmbedder = cached
+	}
+
+	serviceOpts := []service.Option{
+		service.WithRetrievalMode(retrievalModes[cfg.Retrieval.Mode]),
+	}
+	checks := []server.Option{
+		server.WithHealthCheck("qdrant", retriever.Check),
+		server.WithHealthCheck("embedder", embedder.Check),
+	}
+	if cfg.Rerank.URL != "" {
+		reranker := adapter.NewRerankClient(cfg.Rerank.URL, cfg.Rerank.Model, cfg.Rerank.APIKey, cfg.Rerank.Timeout)
+		serviceOpts = append(serviceOpts,
+			service.WithReranker(reranker),
+			service.WithRerankFactor(int32(cfg.Rerank.Factor)),
+			service.WithMaxCandidates(int32(cfg.Rerank.MaxCandidates)),
+		)
+		checks = append(checks, server.WithHealthCheck("reranker", reranker.Check))
+	}
+
+	svc := service.NewValidator(service.NewService(retriever, queryEmbedder, serviceOpts...),
+		service.WithDefaultLimit(int32(cfg.Limits.DefaultLimit)),
+		service.WithMaxLimit(int32(cfg.Limits.MaxLimit)),
+		service.WithMaxQueryLength(cfg.Limits.MaxQueryLength),
+		service.WithMaxBatchSize(cfg.Limits.MaxBatchSize),
+		service.WithDefaultMode(retrievalModes[cfg.Retrieval.Mode]),
 	)
 
+	listener, err := net.Listen("tcp", cfg.Server.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", cfg.Server.ListenAddress, err)
+	}
+
+	metrics := interceptor.NewMetrics()
+	expvar.Publish("grpc", metrics)
+	opts := []server.Option{
+		server.WithListener(listener),
+		server.WithShutdownTimeout(cfg.Server.ShutdownTimeout),
+		server.WithServiceV1(svc),
+		server.WithServiceV2(svc),
+		server.WithMetrics(metrics),
+	}
+	opts = append(opts, checks...)
+	if cfg.Server.TLSCertFile != "" {
+		opts = append(opts,
+			server.WithTLS(cfg.Server.TLSCertFile, cfg.Server.TLSKeyFile),
+			server.WithClientCA(cfg.Server.TLSClientCAFile),
+		)
+	}
+	if len(cfg.Server.AuthTokens) > 0 {
+		opts = append(opts, server.WithTokens(cfg.Server.AuthTokens...))
+	}
+	if cfg.Server.Reflection {
+		opts = append(opts, server.WithReflection())
+	}
+	s := server.NewServer(opts...)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
@@ -42,5 +142,19 @@ func Run() error {
 		cancel()
 	}()
 
+	if cfg.Server.MetricsAddress != "" {
+		metricsListener, err := net.Listen("tcp", cfg.Server.MetricsAddress)
+		if err != nil {
+			cancel()
+			return fmt.Errorf("failed to listen on %s: %w", cfg.Server.MetricsAddress, err)
+		}
+		go func() {
+			if err := vars.Serve(ctx, metricsListener); err != nil {
+				slog.Warn("metrics server stopped", slog.Any("error", err))
+			}
+		}()
+	}
+
+	defer metrics.LogSummary()
 	return s.Serve(ctx)
 }
@@ -0,0 +1,448 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qdrant/go-client/qdrant"
+	"gopkg.in/yaml.v3"
+
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+// 설정을 출력할 때 비밀 값 대신 보여줄 문자열.
+const redacted = "REDACTED"
+
+// 패시지 검색 서비스 설정.
+// 기본값, YAML 설정 파일, 환경변수, 명령행 플래그 순서로 읽으며 나중에 읽은 값이 앞의 값을 덮어씁니다.
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Qdrant    QdrantConfig    `yaml:"qdrant"`
+	Embedding EmbeddingConfig `yaml:"embedding"`
+	Retrieval RetrievalConfig `yaml:"retrieval"`
+	Rerank    RerankConfig    `yaml:"rerank"`
+	Limits    LimitsConfig    `yaml:"limits"`
+}
+
+// gRPC 서버 설정.
+type ServerConfig struct {
+	// 요청을 받을 주소. e.g., ":50051", "127.0.0.1:50051"
+	ListenAddress string `yaml:"listen_address"`
+	// 종료할 때 처리 중인 요청을 기다리는 최대 시간.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// grpcurl로 서비스를 조회할 수 있도록 서버 리플렉션을 등록할지 여부.
+	Reflection bool `yaml:"reflection"`
+	// 서버 인증서와 개인 키 파일. 설정하면 TLS로 요청을 받습니다.
+	TLSCertFile string `yaml:"tls_cert_file"`
+	TLSKeyFile  string `yaml:"tls_key_file"`
+	// 클라이언트 인증서를 확인할 CA 파일. 설정하면 mTLS를 요구합니다.
+	TLSClientCAFile string `yaml:"tls_client_ca_file"`
+	// 허용할 bearer 토큰 목록. 비어 있으면 인증하지 않습니다.
+	AuthTokens []string `yaml:"auth_tokens"`
+	// gRPC 호출 집계와 캐시 적중률을 /debug/vars로 제공할 HTTP 주소. 비어 있으면 제공하지 않습니다.
+	MetricsAddress string `yaml:"metrics_address"`
+}
+
+// 패시지를 저장한 Qdrant 설정.
+type QdrantConfig struct {
+	Host string `yaml:"host"`
+	// gRPC 포트.
+	Port   int    `yaml:"port"`
+	APIKey string `yaml:"api_key"`
+	// TLS 연결 여부.
+	TLS bool `yaml:"tls"`
+	// 패시지를 저장한 컬렉션 이름.
+	Collection string `yaml:"collection"`
+	// 밀집 벡터 이름. 비어 있으면 컬렉션의 기본 벡터를 사용합니다.
+	DenseVector string `yaml:"dense_vector"`
+	// 희소 벡터 이름.
+	SparseVector string `yaml:"sparse_vector"`
+	// 쿼리를 희소 벡터로 바꾸는 Qdrant 추론 모델. 색인할 때와 같은 모델이어야 합니다.
+	SparseModel string `yaml:"sparse_model"`
+}
+
+// OpenAI 호환 임베딩 서버 설정.
+type EmbeddingConfig struct {
+	// API 주소. e.g., "http://localhost:8080/v1"
+	URL string `yaml:"url"`
+	// 임베딩 모델 이름. 모델이 하나인 서버는 비워 둘 수 있습니다.
+	Model  string `yaml:"model"`
+	APIKey string `yaml:"api_key"`
+	// 요청 한 번의 기한.
+	Timeout time.Duration `yaml:"timeout"`
+	// 실패한 요청을 다시 시도하는 최대 횟수.
+	MaxRetries int `yaml:"max_retries"`
+	// 캐시에 저장하는 최대 쿼리 임베딩 수. 0이면 캐시를 사용하지 않습니다.
+	CacheSize int `yaml:"cache_size"`
+	// 쿼리 임베딩을 캐시에 저장하는 기간.
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+	// 종료할 때 캐시를 저장하고 시작할 때 읽는 파일. 비어 있으면 메모리에만 저장합니다.
+	CacheFile string `yaml:"cache_file"`
+}
+
+// 검색 방식 설정.
+type RetrievalConfig struct {
+	// 검색 방식을 지정하지 않은 요청의 검색 방식. "dense", "sparse", "hybrid" 중 하나입니다.
+	Mode string `yaml:"mode"`
+	// 하이브리드 검색에서 두 방식의 결과를 합치는 방식. "rrf", "dbsf" 중 하나입니다.
+	Fusion string `yaml:"fusion"`
+	// 하이브리드 검색에서 방식마다 가져오는 후보 수. 요청한 결과 수의 배수입니다.
+	PrefetchFactor int `yaml:"prefetch_factor"`
+}
+
+// llama.cpp /v1/rerank API 호환 reranker 설정.
+type RerankConfig struct {
+	// API 주소. e.g., "http://localhost:8081/v1" 비어 있으면 순위를 다시 매기지 않습니다.
+	URL string `yaml:"url"`
+	// reranker 모델 이름. 모델이 하나인 서버는 비워 둘 수 있습니다.
+	Model  string `yaml:"model"`
+	APIKey string `yaml:"api_key"`
+	// 요청 한 번의 기한.
+	Timeout time.Duration `yaml:"timeout"`
+	// 순위를 다시 매길 후보 수. 요청한 결과 수의 배수입니다.
+	Factor int `yaml:"factor"`
+	// 순위를 다시 매길 최대 후보 수.
+	MaxCandidates int `yaml:"max_candidates"`
+}
+
+// 설정 파일의 검색 방식 이름.
+var retrievalModes = map[string]passagev2.RetrievalMode{
+	"dense":  passagev2.RetrievalMode_RETRIEVAL_MODE_DENSE,
+	"sparse": passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE,
+	"hybrid": passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+}
+
+// 설정 파일의 결과 합치기 방식 이름.
+var fusions = map[string]qdrant.Fusion{
+	"rrf":  qdrant.Fusion_RRF,
+	"dbsf": qdrant.Fusion_DBSF,
+}
+
+// 검색 요청 제한.
+type LimitsConfig struct {
+	// limit이 0인 요청의 결과 개수.
+	DefaultLimit int `yaml:"default_limit"`
+	// 최대 결과 개수.
+	MaxLimit int `yaml:"max_limit"`
+	// 쿼리의 최대 글자 수.
+	MaxQueryLength int `yaml:"max_query_length"`
+	// 한 번의 일괄 검색에서 처리하는 최대 쿼리 수.
+	MaxBatchSize int `yaml:"max_batch_size"`
+}
+
+// 기본 설정을 반환합니다. Qdrant 호스트와 임베딩 서버 주소는 기본값이 없습니다.
+func DefaultConfig() *Config {
+	return &Config{
+		Server: ServerConfig{
+			ListenAddress:   ":50051",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Qdrant: QdrantConfig{
+			Port:         6334,
+			Collection:   "content",
+			SparseVector: "bm25",
+			SparseModel:  "qdrant/bm25",
+		},
+		Embedding: EmbeddingConfig{
+			Timeout:    30 * time.Second,
+			MaxRetries: 2,
+			CacheSize:  1000,
+			CacheTTL:   24 * time.Hour,
+		},
+		Retrieval: RetrievalConfig{
+			Mode:           "dense",
+			Fusion:         "rrf",
+			PrefetchFactor: 2,
+		},
+		Rerank: RerankConfig{
+			Timeout:       10 * time.Second,
+			Factor:        4,
+			MaxCandidates: 100,
+		},
+		Limits: LimitsConfig{
+			DefaultLimit:   10,
+			MaxLimit:       100,
+			MaxQueryLength: 1000,
+			MaxBatchSize:   32,
+		},
+	}
+}
+
+// 환경변수와 플래그로 바꿀 수 있는 설정 값.
+type setting struct {
+	flag  string
+	env   string
+	usage string
+	// 참/거짓 값이면 플래그에 값을 생략할 수 있습니다.
+	isBool bool
+	set    func(c *Config, v string) error
+}
+
+var settings = []setting{
+	{flag: "listen-address", env: "LISTEN_ADDRESS", usage: "요청을 받을 주소", set: setString(func(c *Config) *string { return &c.Server.ListenAddress })},
+	{flag: "shutdown-timeout", env: "SHUTDOWN_TIMEOUT", usage: "종료할 때 처리 중인 요청을 기다리는 최대 시간", set: setDuration(func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout })},
+	{flag: "reflection", env: "GRPC_REFLECTION", usage: "서버 리플렉션 등록 여부", isBool: true, set: setBool(func(c *Config) *bool { return &c.Server.Reflection })},
+	{flag: "tls-cert-file", env: "TLS_CERT_FILE", usage: "서버 인증서 파일", set: setString(func(c *Config) *string { return &c.Server.TLSCertFile })},
+	{flag: "tls-key-file", env: "TLS_KEY_FILE", usage: "서버 개인 키 파일", set: setString(func(c *Config) *string { return &c.Server.TLSKeyFile })},
+	{flag: "tls-client-ca-file", env: "TLS_CLIENT_CA_FILE", usage: "클라이언트 인증서를 확인할 CA 파일", set: setString(func(c *Config) *string { return &c.Server.TLSClientCAFile })},
+	{flag: "auth-tokens", env: "AUTH_TOKENS", usage: "쉼표로 구분된 허용할 bearer 토큰 목록", set: setList(func(c *Config) *[]string { return &c.Server.AuthTokens })},
+	{flag: "metrics-address", env: "METRICS_ADDRESS", usage: "gRPC 호출 집계를 /debug/vars로 제공할 HTTP 주소", set: setString(func(c *Config) *string { return &c.Server.MetricsAddress })},
+	{flag: "qdrant-host", env: "QDRANT_HOST", usage: "Qdrant 호스트", set: setString(func(c *Config) *string { return &c.Qdrant.Host })},
+	{flag: "qdrant-port", env: "QDRANT_PORT", usage: "Qdrant gRPC 포트", set: setInt(func(c *Config) *int { return &c.Qdrant.Port })},
+	{flag: "qdrant-api-key", env: "QDRANT_API_KEY", usage: "Qdrant API 키", set: setString(func(c *Config) *string { return &c.Qdrant.APIKey })},
+	{flag: "qdrant-tls", env: "QDRANT_TLS", usage: "Qdrant TLS 연결 여부", isBool: true, set: setBool(func(c *Config) *bool { return &c.Qdrant.TLS })},
+	{flag: "qdrant-collection", env: "QDRANT_COLLECTION", usage: "패시지를 저장한 Qdrant 컬렉션", set: setString(func(c *Config) *string { return &c.Qdrant.Collection })},
+	{flag: "qdrant-dense-vector", env: "QDRANT_DENSE_VECTOR", usage: "밀집 벡터 이름", set: setString(func(c *Config) *string { return &c.Qdrant.DenseVector })},
+	{flag: "qdrant-sparse-vector", env: "QDRANT_SPARSE_VECTOR", usage: "희소 벡터 이름", set: setString(func(c *Config) *string { return &c.Qdrant.SparseVector })},
+	{flag: "qdrant-sparse-model", env: "QDRANT_SPARSE_MODEL", usage: "쿼리를 희소 벡터로 바꾸는 Qdrant 추론 모델", set: setString(func(c *Config) *string { return &c.Qdrant.SparseModel })},
+	{flag: "embedding-url", env: "EMBEDDING_API_URL", usage: "임베딩 서버 API 주소", set: setString(func(c *Config) *string { return &c.Embedding.URL })},
+	{flag: "embedding-model", env: "EMBEDDING_MODEL", usage: "임베딩 모델 이름", set: setString(func(c *Config) *string { return &c.Embedding.Model })},
+	{flag: "embedding-api-key", env: "EMBEDDING_API_KEY", usage: "임베딩 서버 API 키", set: setString(func(c *Config) *string { return &c.Embedding.APIKey })},
+	{flag: "embedding-timeout", env: "EMBEDDING_TIMEOUT", usage: "임베딩 요청 한 번의 기한", set: setDuration(func(c *Config) *time.Duration { return &c.Embedding.Timeout })},
+	{flag: "embedding-max-retries", env: "EMBEDDING_MAX_RETRIES", usage: "임베딩 요청의 최대 재시도 횟수", set: setInt(func(c *Config) *int { return &c.Embedding.MaxRetries })},
+	{flag: "embedding-cache-size", env: "EMBEDDING_CACHE_SIZE", usage: "캐시에 저장하는 최대 쿼리 임베딩 수. 0이면 캐시를 사용하지 않습니다", set: setInt(func(c *Config) *int { return &c.Embedding.CacheSize })},
+	{flag: "embedding-cache-ttl", env: "EMBEDDING_CACHE_TTL", usage: "쿼리 임베딩을 캐시에 저장하는 기간", set: setDuration(func(c *Config) *time.Duration { return &c.Embedding.CacheTTL })},
+	{flag: "embedding-cache-file", env: "EMBEDDING_CACHE_FILE", usage: "재시작해도 캐시를 유지하도록 저장하는 파일", set: setString(func(c *Config) *string { return &c.Embedding.CacheFile })},
+	{flag: "retrieval-mode", env: "RETRIEVAL_MODE", usage: "기본 검색 방식 (dense, sparse, hybrid)", set: setString(func(c *Config) *string { return &c.Retrieval.Mode })},
+	{flag: "fusion", env: "FUSION", usage: "하이브리드 검색 결과를 합치는 방식 (rrf, dbsf)", set: setString(func(c *Config) *string { return &c.Retrieval.Fusion })},
+	{flag: "prefetch-factor", env: "PREFETCH_FACTOR", usage: "하이브리드 검색에서 방식마다 가져오는 후보 수의 배수", set: setInt(func(c *Config) *int { return &c.Retrieval.PrefetchFactor })},
+	{flag: "rerank-url", env: "RERANK_API_URL", usage: "reranker 서버 API 주소. 비어 있으면 순위를 다시 매기지 않습니다", set: setString(func(c *Config) *string { return &c.Rerank.URL })},
+	{flag: "rerank-model", env: "RERANK_MODEL", usage: "reranker 모델 이름", set: setString(func(c *Config) *string { return &c.Rerank.Model })},
+	{flag: "rerank-api-key", env: "RERANK_API_KEY", usage: "reranker 서버 API 키", set: setString(func(c *Config) *string { return &c.Rerank.APIKey })},
+	{flag: "rerank-timeout", env: "RERANK_TIMEOUT", usage: "reranker 요청 한 번의 기한", set: setDuration(func(c *Config) *time.Duration { return &c.Rerank.Timeout })},
+	{flag: "rerank-factor", env: "RERANK_FACTOR", usage: "순위를 다시 매길 후보 수의 배수", set: setInt(func(c *Config) *int { return &c.Rerank.Factor })},
+	{flag: "rerank-max-candidates", env: "RERANK_MAX_CANDIDATES", usage: "순위를 다시 매길 최대 후보 수", set: setInt(func(c *Config) *int { return &c.Rerank.MaxCandidates })},
+	{flag: "default-limit", env: "DEFAULT_LIMIT", usage: "limit이 0인 요청의 결과 개수", set: setInt(func(c *Config) *int { return &c.Limits.DefaultLimit })},
+	{flag: "max-limit", env: "MAX_LIMIT", usage: "최대 결과 개수", set: setInt(func(c *Config) *int { return &c.Limits.MaxLimit })},
+	{flag: "max-query-length", env: "MAX_QUERY_LENGTH", usage: "쿼리의 최대 글자 수", set: setInt(func(c *Config) *int { return &c.Limits.MaxQueryLength })},
+	{flag: "max-batch-size", env: "MAX_BATCH_SIZE", usage: "일괄 검색의 최대 쿼리 수", set: setInt(func(c *Config) *int { return &c.Limits.MaxBatchSize })},
+}
+
+func setString(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+func setList(field func(*Config) *[]string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		var items []string
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		*field(c) = items
+		return nil
+	}
+}
+
+func setInt(field func(*Config) *int) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", v)
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func setBool(field func(*Config) *bool) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", v)
+		}
+		*field(c) = b
+		return nil
+	}
+}
+
+func setDuration(field func(*Config) *time.Duration) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", v)
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+// 명령행 인자 args와 환경변수로 설정을 읽고 확인합니다.
+// 설정 파일은 --config 플래그나 CONFIG_FILE 환경변수로 지정합니다.
+// --print-config 플래그를 주면 printConfig가 참이고, 잘못된 설정도 출력할 수 있도록 설정을 확인하지 않습니다.
+func LoadConfig(args []string) (cfg *Config, printConfig bool, err error) {
+	fs := flag.NewFlagSet("dense-retrieval-service", flag.ExitOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "YAML 설정 파일 (기본값: CONFIG_FILE 환경변수)")
+	fs.BoolVar(&printConfig, "print-config", false, "최종 설정을 YAML로 출력하고 종료")
+
+	// 플래그는 설정 파일과 환경변수를 읽은 뒤에 적용한다.
+	type flagValue struct {
+		setting setting
+		value   string
+	}
+	var flags []flagValue
+	for _, s := range settings {
+		usage := fmt.Sprintf("%s (%s 환경변수)", s.usage, s.env)
+		record := func(v string) error {
+			flags = append(flags, flagValue{setting: s, value: v})
+			return nil
+		}
+		if s.isBool {
+			fs.BoolFunc(s.flag, usage, record)
+		} else {
+			fs.Func(s.flag, usage, record)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+
+	cfg = DefaultConfig()
+	if *configFile != "" {
+		if err := cfg.load(*configFile); err != nil {
+			return nil, false, err
+		}
+	}
+	for _, s := range settings {
+		v, ok := os.LookupEnv(s.env)
+		if !ok {
+			continue
+		}
+		if err := s.set(cfg, v); err != nil {
+			return nil, false, fmt.Errorf("%s: %w", s.env, err)
+		}
+	}
+	for _, f := range flags {
+		if err := f.setting.set(cfg, f.value); err != nil {
+			return nil, false, fmt.Errorf("--%s: %w", f.setting.flag, err)
+		}
+	}
+
+	// 호출한 쪽에서 출력한 뒤 확인한다.
+	if printConfig {
+		return cfg, true, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, false, err
+	}
+	return cfg, false, nil
+}
+
+// YAML 설정 파일의 값으로 설정을 덮어씁니다. 알 수 없는 항목이 있으면 오류를 반환합니다.
+func (c *Config) load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// 잘못된 설정 값을 모두 모아서 반환합니다.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	if _, _, err := net.SplitHostPort(c.Server.ListenAddress); err != nil {
+		errs = append(errs, fmt.Errorf("server.listen_address: %w", err))
+	}
+	if c.Server.MetricsAddress != "" {
+		if _, _, err := net.SplitHostPort(c.Server.MetricsAddress); err != nil {
+			errs = append(errs, fmt.Errorf("server.metrics_address: %w", err))
+		}
+	}
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout must be positive")
+	check((c.Server.TLSCertFile == "") == (c.Server.TLSKeyFile == ""), "server.tls_cert_file and server.tls_key_file must be set together")
+	check(c.Server.TLSClientCAFile == "" || c.Server.TLSCertFile != "", "server.tls_client_ca_file requires server.tls_cert_file")
+
+	check(c.Qdrant.Host != "", "qdrant.host is required")
+	check(c.Qdrant.Port > 0 && c.Qdrant.Port <= 65535, "qdrant.port must be between 1 and 65535")
+	check(c.Qdrant.Collection != "", "qdrant.collection is required")
+	check(c.Qdrant.SparseVector != "", "qdrant.sparse_vector is required")
+	check(c.Qdrant.SparseModel != "", "qdrant.sparse_model is required")
+
+	if c.Embedding.URL == "" {
+		errs = append(errs, errors.New("embedding.url is required"))
+	} else if u, err := url.Parse(c.Embedding.URL); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, fmt.Errorf("embedding.url must be an absolute URL: %q", c.Embedding.URL))
+	}
+	check(c.Embedding.Timeout > 0, "embedding.timeout must be positive")
+	check(c.Embedding.MaxRetries >= 0, "embedding.max_retries must not be negative")
+	check(c.Embedding.CacheSize >= 0, "embedding.cache_size must not be negative")
+	// 캐시를 사용하지 않으면 캐시 기간과 파일은 사용하지 않는다.
+	if c.Embedding.CacheSize > 0 {
+		check(c.Embedding.CacheTTL > 0, "embedding.cache_ttl must be positive")
+	} else {
+		check(c.Embedding.CacheFile == "", "embedding.cache_file requires a positive embedding.cache_size")
+	}
+
+	_, ok := retrievalModes[c.Retrieval.Mode]
+	check(ok, "retrieval.mode must be one of dense, sparse, hybrid (got %q)", c.Retrieval.Mode)
+	_, ok = fusions[c.Retrieval.Fusion]
+	check(ok, "retrieval.fusion must be one of rrf, dbsf (got %q)", c.Retrieval.Fusion)
+	check(c.Retrieval.PrefetchFactor > 0, "retrieval.prefetch_factor must be positive")
+
+	if c.Rerank.URL != "" {
+		if u, err := url.Parse(c.Rerank.URL); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("rerank.url must be an absolute URL: %q", c.Rerank.URL))
+		}
+	}
+	check(c.Rerank.Timeout > 0, "rerank.timeout must be positive")
+	check(c.Rerank.Factor > 0, "rerank.factor must be positive")
+	check(c.Rerank.MaxCandidates > 0 && c.Rerank.MaxCandidates <= math.MaxInt32, "rerank.max_candidates must be between 1 and %d", math.MaxInt32)
+
+	check(c.Limits.DefaultLimit > 0, "limits.default_limit must be positive")
+	check(c.Limits.MaxLimit > 0 && c.Limits.MaxLimit <= math.MaxInt32, "limits.max_limit must be between 1 and %d", math.MaxInt32)
+	check(c.Limits.DefaultLimit <= c.Limits.MaxLimit, "limits.default_limit must not exceed limits.max_limit")
+	check(c.Limits.MaxQueryLength > 0, "limits.max_query_length must be positive")
+	check(c.Limits.MaxBatchSize > 0, "limits.max_batch_size must be positive")
+
+	return errors.Join(errs...)
+}
+
+// 설정을 YAML로 w에 씁니다. API 키와 토큰은 가려서 출력합니다.
+func (c *Config) Print(w io.Writer) error {
+	out := *c
+	if out.Qdrant.APIKey != "" {
+		out.Qdrant.APIKey = redacted
+	}
+	if out.Embedding.APIKey != "" {
+		out.Embedding.APIKey = redacted
+	}
+	if out.Rerank.APIKey != "" {
+		out.Rerank.APIKey = redacted
+	}
+	if len(out.Server.AuthTokens) > 0 {
+		out.Server.AuthTokens = []string{redacted}
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("failed to print config: %w", err)
+	}
+	return enc.Close()
+}
@@ -0,0 +1,318 @@
+package app_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		yaml      string
+		env       map[string]string
+		args      []string
+		expectErr string
+		// 기대하는 listen_address, collection, cache_ttl.
+		expectAddress    string
+		expectCollection string
+		expectCacheTTL   time.Duration
+	}{
+		{
+			desc:             "defaults",
+			expectAddress:    ":50051",
+			expectCollection: "content",
+			expectCacheTTL:   24 * time.Hour,
+		},
+		{
+			desc:             "file overrides defaults",
+			yaml:             "server:\n  listen_address: \":6000\"\nqdrant:\n  collection: file\n",
+			expectAddress:    ":6000",
+			expectCollection: "file",
+			expectCacheTTL:   24 * time.Hour,
+		},
+		{
+			desc:             "env overrides file",
+			yaml:             "server:\n  listen_address: \":6000\"\nqdrant:\n  collection: file\n",
+			env:              map[string]string{"QDRANT_COLLECTION": "env", "EMBEDDING_CACHE_TTL": "1h"},
+			expectAddress:    ":6000",
+			expectCollection: "env",
+			expectCacheTTL:   time.Hour,
+		},
+		{
+			desc:             "flags override env",
+			yaml:             "qdrant:\n  collection: file\n",
+			env:              map[string]string{"QDRANT_COLLECTION": "env", "LISTEN_ADDRESS": ":7000"},
+			args:             []string{"--qdrant-collection", "flag", "--embedding-cache-ttl=5m"},
+			expectAddress:    ":7000",
+			expectCollection: "flag",
+			expectCacheTTL:   5 * time.Minute,
+		},
+		{
+			desc:      "unknown field in file",
+			yaml:      "qdrant:\n  colection: typo\n",
+			expectErr: "field colection not found",
+		},
+		{
+			desc:      "invalid env value",
+			env:       map[string]string{"QDRANT_PORT": "port"},
+			expectErr: "QDRANT_PORT",
+		},
+		{
+			desc:      "invalid flag value",
+			args:      []string{"--embedding-timeout", "soon"},
+			expectErr: "--embedding-timeout",
+		},
+		{
+			desc:      "validate after overrides",
+			args:      []string{"--max-limit", "0"},
+			expectErr: "limits.max_limit",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// 필수 설정. 설정 파일과 환경변수로 덮어쓸 수 있다.
+			t.Setenv("QDRANT_HOST", "localhost")
+			t.Setenv("EMBEDDING_API_URL", "http://localhost:8080/v1")
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			args := tc.args
+			if tc.yaml != "" {
+				path := filepath.Join(t.TempDir(), "config.yaml")
+				if err := os.WriteFile(path, []byte(tc.yaml), 0o644); err != nil {
+					t.Fatalf("failed to write config: %v", err)
+				}
+				args = append([]string{"--config", path}, args...)
+			}
+
+			cfg, printConfig, err := app.LoadConfig(args)
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load config: %v", err)
+			}
+
+			if printConfig {
+				t.Error("expected printConfig false, got true")
+			}
+			if cfg.Server.ListenAddress != tc.expectAddress {
+				t.Errorf("expected listen address %q, got %q", tc.expectAddress, cfg.Server.ListenAddress)
+			}
+			if cfg.Qdrant.Collection != tc.expectCollection {
+				t.Errorf("expected collection %q, got %q", tc.expectCollection, cfg.Qdrant.Collection)
+			}
+			if cfg.Embedding.CacheTTL != tc.expectCacheTTL {
+				t.Errorf("expected cache ttl %v, got %v", tc.expectCacheTTL, cfg.Embedding.CacheTTL)
+			}
+		})
+	}
+}
+
+func TestLoadConfigPrintSkipsValidation(t *testing.T) {
+	cfg, printConfig, err := app.LoadConfig([]string{"--print-config", "--qdrant-host", "", "--max-limit", "0"})
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if !printConfig {
+		t.Error("expected printConfig true, got false")
+	}
+	if cfg.Limits.MaxLimit != 0 {
+		t.Errorf("expected max limit 0, got %d", cfg.Limits.MaxLimit)
+	}
+}
+
+// 필수 설정을 채운 기본 설정.
+func validConfig() *app.Config {
+	cfg := app.DefaultConfig()
+	cfg.Qdrant.Host = "localhost"
+	cfg.Embedding.URL = "http://localhost:8080/v1"
+	return cfg
+}
+
+func TestConfigValidate(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		modify func(c *app.Config)
+		// 오류 메시지에 포함되어야 하는 설정 이름. 비어 있으면 오류가 없어야 합니다.
+		expectErrs []string
+	}{
+		{
+			desc:   "valid",
+			modify: func(c *app.Config) {},
+		},
+		{
+			desc: "required fields",
+			modify: func(c *app.Config) {
+				c.Qdrant.Host = ""
+				c.Embedding.URL = ""
+			},
+			expectErrs: []string{"qdrant.host", "embedding.url"},
+		},
+		{
+			desc: "collect all errors",
+			modify: func(c *app.Config) {
+				c.Server.ListenAddress = "50051"
+				c.Embedding.URL = "localhost:8080"
+				c.Retrieval.Mode = "keyword"
+				c.Limits.DefaultLimit = 200
+			},
+			expectErrs: []string{"server.listen_address", "embedding.url", "retrieval.mode", "limits.default_limit"},
+		},
+		{
+			desc: "tls files must be set together",
+			modify: func(c *app.Config) {
+				c.Server.TLSCertFile = "cert.pem"
+			},
+			expectErrs: []string{"server.tls_cert_file"},
+		},
+		{
+			desc: "invalid metrics address",
+			modify: func(c *app.Config) {
+				c.Server.MetricsAddress = "9090"
+			},
+			expectErrs: []string{"server.metrics_address"},
+		},
+		{
+			desc: "cache ttl is checked when cache is enabled",
+			modify: func(c *app.Config) {
+				c.Embedding.CacheTTL = 0
+			},
+			expectErrs: []string{"embedding.cache_ttl"},
+		},
+		{
+			desc: "cache ttl is ignored when cache is disabled",
+			modify: func(c *app.Config) {
+				c.Embedding.CacheSize = 0
+				c.Embedding.CacheTTL = 0
+			},
+		},
+		{
+			desc: "cache file requires cache",
+			modify: func(c *app.Config) {
+				c.Embedding.CacheSize = 0
+				c.Embedding.CacheFile = "cache.jsonl"
+			},
+			expectErrs: []string{"embedding.cache_file"},
+		},
+		{
+			desc: "rerank url must be absolute",
+			modify: func(c *app.Config) {
+				c.Rerank.URL = "/v1/rerank"
+			},
+			expectErrs: []string{"rerank.url"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := validConfig()
+			tc.modify(cfg)
+
+			err := cfg.Validate()
+			if len(tc.expectErrs) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors %v, got nil", tc.expectErrs)
+			}
+			for _, want := range tc.expectErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error containing %q, got %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigPrint(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		modify func(c *app.Config)
+		expect map[string]string
+	}{
+		{
+			desc: "redact secrets",
+			modify: func(c *app.Config) {
+				c.Qdrant.APIKey = "qdrant-secret"
+				c.Embedding.APIKey = "embedding-secret"
+				c.Rerank.APIKey = "rerank-secret"
+				c.Server.AuthTokens = []string{"token-1", "token-2"}
+			},
+			expect: map[string]string{
+				"qdrant":    "REDACTED",
+				"embedding": "REDACTED",
+				"rerank":    "REDACTED",
+				"tokens":    "[REDACTED]",
+			},
+		},
+		{
+			desc:   "keep empty secrets empty",
+			modify: func(c *app.Config) {},
+			expect: map[string]string{
+				"qdrant":    "",
+				"embedding": "",
+				"rerank":    "",
+				"tokens":    "[]",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := validConfig()
+			tc.modify(cfg)
+
+			var buf bytes.Buffer
+			if err := cfg.Print(&buf); err != nil {
+				t.Fatalf("failed to print config: %v", err)
+			}
+			for _, secret := range []string{"secret", "token-1"} {
+				if strings.Contains(buf.String(), secret) {
+					t.Errorf("expected %q to be redacted, got\n%s", secret, buf.String())
+				}
+			}
+
+			// 출력한 설정을 다시 읽을 수 있어야 한다.
+			var printed app.Config
+			dec := yaml.NewDecoder(&buf)
+			dec.KnownFields(true)
+			if err := dec.Decode(&printed); err != nil {
+				t.Fatalf("failed to parse printed config: %v", err)
+			}
+			got := map[string]string{
+				"qdrant":    printed.Qdrant.APIKey,
+				"embedding": printed.Embedding.APIKey,
+				"rerank":    printed.Rerank.APIKey,
+				"tokens":    "[" + strings.Join(printed.Server.AuthTokens, ",") + "]",
+			}
+			for k, want := range tc.expect {
+				if got[k] != want {
+					t.Errorf("expected %s %q, got %q", k, want, got[k])
+				}
+			}
+
+			// 출력할 때 원래 설정은 바꾸지 않는다.
+			if cfg.Qdrant.APIKey == "REDACTED" || slices.Contains(cfg.Server.AuthTokens, "REDACTED") {
+				t.Error("expected original config to keep secrets, got REDACTED")
+			}
+		})
+	}
+}
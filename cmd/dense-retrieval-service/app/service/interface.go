@@ -1,21 +1,69 @@
 package service
 
-import "context"
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+var (
+	// 검색 조건을 벡터 저장소의 조건으로 바꿀 수 없을 때 반환하는 오류.
+	ErrInvalidFilter = errors.New("invalid filter")
+	// 벡터 저장소가 요청한 검색 방식을 지원하지 않을 때 반환하는 오류. e.g., 컬렉션에 희소 벡터가 없는 경우
+	ErrUnsupportedMode = errors.New("unsupported retrieval mode")
+)
 
 type RetrieveParams struct {
+	// 검색 방식. RETRIEVAL_MODE_UNSPECIFIED이면 밀집 벡터로 검색합니다.
+	Mode passage.RetrievalMode
+	// 쿼리 임베딩. 희소 벡터로만 검색할 때는 비어 있습니다.
 	Vectors []float32
-	Limit   int32
+	// 쿼리 원문. 희소 벡터 검색에 사용합니다.
+	Query string
+	Limit int32
+	// 건너뛸 상위 결과 수.
+	Offset int32
+	// 패시지 메타데이터 조건. nil이면 제한하지 않습니다.
+	Filter *passage.Filter
 }
 
 type RetrieveResult struct {
-	Score   float32
+	// 포인트 ID.
+	ID    string
+	Score float32
+	// 포인트 payload.
+	Payload map[string]*structpb.Value
+	// 포인트 payload의 원본 JSON. v1 응답에 그대로 사용합니다.
 	Passage []byte
+	// Reranker가 계산한 관련도 점수. 다시 순위를 매기지 않았으면 nil입니다.
+	RerankScore *float32
+}
+
+// 여러 쿼리 검색에서 쿼리 하나의 결과.
+type RetrieveBatchResult struct {
+	Results []RetrieveResult
+	// 쿼리 하나의 실패 원인. 조건이 잘못되었으면 ErrInvalidFilter를, 검색 방식을 지원하지 않으면 ErrUnsupportedMode를 감싼 오류입니다.
+	Err error
 }
 
 type VectorRetriever interface {
+	// 조건이 잘못되었으면 ErrInvalidFilter를, 검색 방식을 지원하지 않으면 ErrUnsupportedMode를 감싼 오류를 반환합니다.
 	Retrieve(ctx context.Context, params RetrieveParams) ([]RetrieveResult, error)
+	// 여러 쿼리를 한 번의 요청으로 검색해서 params와 같은 순서로 결과를 반환합니다.
+	RetrieveBatch(ctx context.Context, params []RetrieveParams) ([]RetrieveBatchResult, error)
+}
+
+// 쿼리와 문서를 함께 읽고 관련도를 다시 계산하는 cross-encoder.
+type Reranker interface {
+	// documents와 같은 순서로 관련도 점수를 반환합니다. 점수가 클수록 쿼리와 관련이 높습니다.
+	Rerank(ctx context.Context, query string, documents []string) ([]float32, error)
 }
 
 type Embedder interface {
 	Embed(ctx context.Context, text string) ([]float32, error)
+	// 여러 텍스트를 한 번의 요청으로 임베딩해서 texts와 같은 순서로 반환합니다.
+	EmbedBatch(ctx context.Context, texts []string) ([][]float32, error)
 }
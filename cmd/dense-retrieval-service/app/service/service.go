@@ -1,13 +1,123 @@
 package service
 
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+// 스트리밍 검색에서 한 번에 검색하는 패시지 수.
+const streamBatchSize int32 = 10
+
+type serviceOptions struct {
+	// 검색 결과의 순위를 다시 매기는 reranker. nil이면 검색 순위를 그대로 사용합니다.
+	reranker Reranker
+	// 다시 순위를 매길 후보 수. 요청한 결과 수의 배수입니다.
+	rerankFactor int32
+	// 다시 순위를 매길 최대 후보 수. 요청한 결과 수가 더 크면 결과 수만큼 가져옵니다.
+	maxCandidates int32
+	// 검색 방식을 지정하지 않은 요청의 검색 방식. 검색 방식을 고를 수 없는 v1 요청도 이 방식으로 검색합니다.
+	mode passage.RetrievalMode
+}
+
+var defaultServiceOptions = serviceOptions{
+	rerankFactor:  4,
+	maxCandidates: 100,
+	mode:          passage.RetrievalMode_RETRIEVAL_MODE_DENSE,
+}
+
+type Option func(*serviceOptions)
+
+func WithReranker(reranker Reranker) Option {
+	return func(opt *serviceOptions) {
+		opt.reranker = reranker
+	}
+}
+
+func WithRerankFactor(factor int32) Option {
+	return func(opt *serviceOptions) {
+		opt.rerankFactor = factor
+	}
+}
+
+func WithMaxCandidates(n int32) Option {
+	return func(opt *serviceOptions) {
+		opt.maxCandidates = n
+	}
+}
+
+func WithRetrievalMode(mode passage.RetrievalMode) Option {
+	return func(opt *serviceOptions) {
+		opt.mode = mode
+	}
+}
+
 type Service struct {
 	VectorRetriever VectorRetriever
 	Embedder        Embedder
+
+	options *serviceOptions
 }
 
-func NewService(v VectorRetriever, e Embedder) *Service {
+func NewService(v VectorRetriever, e Embedder, opts ...Option) *Service {
+	options := defaultServiceOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	return &Service{
 		VectorRetriever: v,
 		Embedder:        e,
+		options:         &options,
+	}
+}
+
+// 검색 방식을 지정하지 않았으면 기본 검색 방식을 반환합니다.
+func (s *Service) mode(mode passage.RetrievalMode) passage.RetrievalMode {
+	if mode == passage.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED {
+		return s.options.mode
+	}
+	return mode
+}
+
+// limit개 결과를 얻기 위해 검색할 후보 수. reranker가 없으면 limit입니다.
+func (s *Service) candidates(limit int32) int32 {
+	if s.options.reranker == nil {
+		return limit
+	}
+	// limit과 배수가 커도 넘치지 않도록 int64로 계산한다.
+	n := min(int64(limit)*int64(s.options.rerankFactor), int64(s.options.maxCandidates))
+	return max(int32(n), limit)
+}
+
+// 후보의 순위를 reranker로 다시 매기고 상위 limit개를 반환합니다. reranker가 없으면 후보를 그대로 반환합니다.
+func (s *Service) rerank(ctx context.Context, query string, candidates []RetrieveResult, limit int32) ([]RetrieveResult, error) {
+	if s.options.reranker == nil || len(candidates) == 0 {
+		return candidates, nil
+	}
+
+	documents := make([]string, len(candidates))
+	for i, c := range candidates {
+		documents[i] = passageText(c)
+	}
+
+	scores, err := s.options.reranker.Rerank(ctx, query, documents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rerank passages: %w", err)
+	}
+	if len(scores) != len(candidates) {
+		return nil, fmt.Errorf("unexpected rerank score count: got %d, want %d", len(scores), len(candidates))
 	}
+
+	for i := range candidates {
+		candidates[i].RerankScore = &scores[i]
+	}
+	// 관련도가 같으면 검색 순위를 유지한다.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return *candidates[i].RerankScore > *candidates[j].RerankScore
+	})
+
+	return candidates[:min(int(limit), len(candidates))], nil
 }
@@ -0,0 +1,303 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+// 검색 요청을 기록하고 요청한 개수만큼 점수가 낮아지는 패시지를 반환하는 VectorRetriever.
+type fakeRetriever struct {
+	// 저장된 패시지 수.
+	total  int
+	params []service.RetrieveParams
+}
+
+func (f *fakeRetriever) Retrieve(ctx context.Context, params service.RetrieveParams) ([]service.RetrieveResult, error) {
+	f.params = append(f.params, params)
+
+	var results []service.RetrieveResult
+	for i := int(params.Offset); i < min(int(params.Offset+params.Limit), f.total); i++ {
+		results = append(results, service.RetrieveResult{
+			ID:      fmt.Sprint(i),
+			Score:   1 / float32(i+1),
+			Payload: map[string]*structpb.Value{"value": structpb.NewStringValue(fmt.Sprintf("passage %d", i))},
+			Passage: fmt.Appendf(nil, `{"value":"passage %d"}`, i),
+		})
+	}
+	return results, nil
+}
+
+func (f *fakeRetriever) RetrieveBatch(ctx context.Context, params []service.RetrieveParams) ([]service.RetrieveBatchResult, error) {
+	results := make([]service.RetrieveBatchResult, len(params))
+	for i, p := range params {
+		results[i].Results, results[i].Err = f.Retrieve(ctx, p)
+	}
+	return results, nil
+}
+
+// 임베딩한 텍스트를 기록하는 Embedder.
+type fakeEmbedder struct {
+	texts []string
+}
+
+func (f *fakeEmbedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	f.texts = append(f.texts, text)
+	return []float32{0.1}, nil
+}
+
+func (f *fakeEmbedder) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i], _ = f.Embed(ctx, text)
+	}
+	return vectors, nil
+}
+
+// 패시지마다 정해진 관련도 점수를 반환하는 Reranker. 점수가 없는 패시지는 0점입니다.
+type fakeReranker struct {
+	scores    map[string]float32
+	documents [][]string
+}
+
+func (f *fakeReranker) Rerank(ctx context.Context, query string, documents []string) ([]float32, error) {
+	f.documents = append(f.documents, documents)
+	scores := make([]float32, len(documents))
+	for i, d := range documents {
+		scores[i] = f.scores[d]
+	}
+	return scores, nil
+}
+
+func TestRetrieveRerank(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		opts  []service.Option
+		total int
+		limit int32
+		// reranker 점수. 나머지 패시지는 0점이다.
+		scores map[string]float32
+		// 검색할 후보 수.
+		expectCandidates int32
+		// 다시 매긴 순위의 패시지 ID와 reranker 점수.
+		expectIDs    []string
+		expectScores []float32
+	}{
+		{
+			desc:             "over-fetch by factor",
+			total:            20,
+			limit:            2,
+			scores:           map[string]float32{"passage 7": 0.9, "passage 3": 0.8},
+			expectCandidates: 8,
+			expectIDs:        []string{"7", "3"},
+			expectScores:     []float32{0.9, 0.8},
+		},
+		{
+			desc:             "custom factor capped by max candidates",
+			opts:             []service.Option{service.WithRerankFactor(10), service.WithMaxCandidates(5)},
+			total:            20,
+			limit:            2,
+			scores:           map[string]float32{"passage 4": 0.9},
+			expectCandidates: 5,
+			// 관련도가 같으면 검색 순위를 유지한다.
+			expectIDs:    []string{"4", "0"},
+			expectScores: []float32{0.9, 0},
+		},
+		{
+			desc:             "limit above max candidates",
+			opts:             []service.Option{service.WithMaxCandidates(2)},
+			total:            20,
+			limit:            3,
+			scores:           map[string]float32{"passage 2": 0.9, "passage 1": 0.5, "passage 0": 0.1},
+			expectCandidates: 3,
+			expectIDs:        []string{"2", "1", "0"},
+			expectScores:     []float32{0.9, 0.5, 0.1},
+		},
+		{
+			desc:             "fewer candidates than limit",
+			total:            2,
+			limit:            3,
+			scores:           map[string]float32{"passage 1": 0.9},
+			expectCandidates: 12,
+			expectIDs:        []string{"1", "0"},
+			expectScores:     []float32{0.9, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			retriever := &fakeRetriever{total: tc.total}
+			reranker := &fakeReranker{scores: tc.scores}
+			s := service.NewService(retriever, &fakeEmbedder{}, append([]service.Option{service.WithReranker(reranker)}, tc.opts...)...)
+
+			passages, err := s.RetrieveV2(context.Background(), "query", tc.limit, nil, passagev2.RetrievalMode_RETRIEVAL_MODE_DENSE)
+			if err != nil {
+				t.Fatalf("failed to retrieve: %v", err)
+			}
+
+			if got := retriever.params[0].Limit; got != tc.expectCandidates {
+				t.Errorf("expected %d candidates, got %d", tc.expectCandidates, got)
+			}
+			if got := len(reranker.documents[0]); got != min(int(tc.expectCandidates), tc.total) {
+				t.Errorf("expected %d documents to rerank, got %d", min(int(tc.expectCandidates), tc.total), got)
+			}
+
+			var (
+				ids    []string
+				scores []float32
+			)
+			for _, p := range passages {
+				ids = append(ids, p.GetId())
+				scores = append(scores, p.GetRerankScore().GetValue())
+				// 검색 점수는 그대로 둔다.
+				if p.GetScore() == 0 {
+					t.Errorf("expected retrieval score for %s, got 0", p.GetId())
+				}
+			}
+			if !slices.Equal(ids, tc.expectIDs) {
+				t.Errorf("expected ids %v, got %v", tc.expectIDs, ids)
+			}
+			if !slices.Equal(scores, tc.expectScores) {
+				t.Errorf("expected rerank scores %v, got %v", tc.expectScores, scores)
+			}
+		})
+	}
+}
+
+// reranker가 있으면 v1 검색과 스트리밍 검색은 후보 전체의 순위를 다시 매기고 reranker 점수를 보낸다.
+func TestRetrieveRerankV1(t *testing.T) {
+	retriever := &fakeRetriever{total: 30}
+	reranker := &fakeReranker{scores: map[string]float32{"passage 11": 0.9, "passage 3": 0.8, "passage 7": 0.7}}
+	s := service.NewService(retriever, &fakeEmbedder{}, service.WithReranker(reranker))
+
+	var streamed []*passage.Passage
+	if err := s.RetrieveStream(context.Background(), "query", 3, nil, func(p *passage.Passage) error {
+		streamed = append(streamed, p)
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to retrieve stream: %v", err)
+	}
+	passages, err := s.Retrieve(context.Background(), "query", 3, nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve: %v", err)
+	}
+
+	// 스트리밍 검색도 10개씩 나누지 않고 후보 전체를 한 번에 검색한다.
+	for _, p := range retriever.params {
+		if p.Limit != 12 || p.Offset != 0 {
+			t.Errorf("expected 12 candidates from offset 0, got %d from %d", p.Limit, p.Offset)
+		}
+	}
+
+	expected := []string{`{"value":"passage 11"}`, `{"value":"passage 3"}`, `{"value":"passage 7"}`}
+	expectedScores := []float32{0.9, 0.8, 0.7}
+	for name, got := range map[string][]*passage.Passage{"stream": streamed, "retrieve": passages} {
+		var (
+			contents []string
+			scores   []float32
+		)
+		for _, p := range got {
+			contents = append(contents, string(p.GetContent()))
+			scores = append(scores, p.GetScore())
+		}
+		if !slices.Equal(contents, expected) {
+			t.Errorf("%s: expected %v, got %v", name, expected, contents)
+		}
+		if !slices.Equal(scores, expectedScores) {
+			t.Errorf("%s: expected scores %v, got %v", name, expectedScores, scores)
+		}
+	}
+}
+
+func TestRetrieveMode(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		opts           []service.Option
+		expectMode     passagev2.RetrievalMode
+		expectEmbedded bool
+	}{
+		{
+			desc:           "default dense",
+			expectMode:     passagev2.RetrievalMode_RETRIEVAL_MODE_DENSE,
+			expectEmbedded: true,
+		},
+		{
+			desc:           "configured hybrid",
+			opts:           []service.Option{service.WithRetrievalMode(passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID)},
+			expectMode:     passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+			expectEmbedded: true,
+		},
+		{
+			desc:       "configured sparse",
+			opts:       []service.Option{service.WithRetrievalMode(passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE)},
+			expectMode: passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			retriever := &fakeRetriever{total: 3}
+			embedder := &fakeEmbedder{}
+			s := service.NewService(retriever, embedder, tc.opts...)
+
+			// v1 API는 검색 방식을 고를 수 없으므로 기본 검색 방식으로 검색한다.
+			if _, err := s.Retrieve(context.Background(), "query", 2, nil); err != nil {
+				t.Fatalf("failed to retrieve: %v", err)
+			}
+			if err := s.RetrieveStream(context.Background(), "query", 2, nil, func(*passage.Passage) error { return nil }); err != nil {
+				t.Fatalf("failed to retrieve stream: %v", err)
+			}
+			if _, err := s.RetrieveV2(context.Background(), "query", 2, nil, passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED); err != nil {
+				t.Fatalf("failed to retrieve v2: %v", err)
+			}
+
+			for _, p := range retriever.params {
+				if p.Mode != tc.expectMode {
+					t.Errorf("expected mode %v, got %v", tc.expectMode, p.Mode)
+				}
+				if p.Query != "query" {
+					t.Errorf("expected query %q, got %q", "query", p.Query)
+				}
+			}
+			if embedded := len(embedder.texts) > 0; embedded != tc.expectEmbedded {
+				t.Errorf("expected embedded %v, got %v", tc.expectEmbedded, embedded)
+			}
+		})
+	}
+}
+
+// 요청한 검색 방식은 기본 검색 방식보다 우선한다.
+func TestRetrieveV2Mode(t *testing.T) {
+	retriever := &fakeRetriever{total: 3}
+	s := service.NewService(retriever, &fakeEmbedder{}, service.WithRetrievalMode(passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID))
+
+	if _, err := s.RetrieveV2(context.Background(), "query", 2, nil, passagev2.RetrievalMode_RETRIEVAL_MODE_DENSE); err != nil {
+		t.Fatalf("failed to retrieve: %v", err)
+	}
+	if _, err := s.BatchRetrieveV2(context.Background(), []*passagev2.RetrieveRequest{
+		{Query: "a", Limit: 1},
+		{Query: "b", Limit: 1, Mode: passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE},
+	}); err != nil {
+		t.Fatalf("failed to batch retrieve: %v", err)
+	}
+
+	expected := []passagev2.RetrievalMode{
+		passagev2.RetrievalMode_RETRIEVAL_MODE_DENSE,
+		passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+		passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE,
+	}
+	var got []passagev2.RetrievalMode
+	for _, p := range retriever.params {
+		got = append(got, p.Mode)
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected modes %v, got %v", expected, got)
+	}
+}
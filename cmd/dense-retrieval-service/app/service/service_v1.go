@@ -2,34 +2,174 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
 
 	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
 	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/server"
 )
 
 var _ server.ServiceV1 = (*Service)(nil)
 
-func (s *Service) Retrieve(ctx context.Context, query string, limit int32) ([]*passage.Passage, error) {
-	vectors, err := s.Embedder.Embed(ctx, query)
+func (s *Service) Retrieve(ctx context.Context, query string, limit int32, filter *passage.Filter) ([]*passage.Passage, error) {
+	f, err := toFilterV2(filter)
 	if err != nil {
-		return nil, err
+		return nil, retrieval.InvalidArgument("filter", err.Error())
 	}
 
-	results, err := s.VectorRetriever.Retrieve(ctx, RetrieveParams{
-		Vectors: vectors,
-		Limit:   limit,
-	})
+	results, err := s.retrieve(ctx, query, limit, f, passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
 
 	passages := make([]*passage.Passage, 0, len(results))
 	for _, result := range results {
-		passages = append(passages, &passage.Passage{
-			Score:   result.Score,
-			Content: result.Passage,
-		})
+		passages = append(passages, toPassageV1(result))
 	}
 
 	return passages, nil
 }
+
+// 상위 결과를 먼저 보낼 수 있도록 streamBatchSize개씩 나눠 기본 검색 방식으로 검색합니다. 질의 임베딩은 한 번만 생성합니다.
+// reranker가 있으면 Retrieve와 같은 순위와 점수가 되도록 후보 전체의 순위를 다시 매긴 뒤 보냅니다.
+func (s *Service) RetrieveStream(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passage.Filter,
+	send func(*passage.Passage) error,
+) error {
+	f, err := toFilterV2(filter)
+	if err != nil {
+		return retrieval.InvalidArgument("filter", err.Error())
+	}
+
+	if s.options.reranker != nil {
+		results, err := s.retrieve(ctx, query, limit, f, passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if err := send(toPassageV1(result)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	mode := s.mode(passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED)
+	var vectors []float32
+	if needsEmbedding(mode) {
+		if vectors, err = s.Embedder.Embed(ctx, query); err != nil {
+			return fmt.Errorf("failed to embed query: %w", err)
+		}
+	}
+
+	for offset := int32(0); offset < limit; offset += streamBatchSize {
+		batch := min(streamBatchSize, limit-offset)
+		results, err := s.search(ctx, RetrieveParams{
+			Mode:    mode,
+			Vectors: vectors,
+			Query:   query,
+			Limit:   batch,
+			Offset:  offset,
+			Filter:  f,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			if err := send(toPassageV1(result)); err != nil {
+				return err
+			}
+		}
+		if int32(len(results)) < batch {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// v1 패시지에는 점수 필드가 하나뿐이므로 다시 순위를 매겼으면 reranker 점수를 사용합니다.
+func toPassageV1(result RetrieveResult) *passage.Passage {
+	score := result.Score
+	if result.RerankScore != nil {
+		score = *result.RerankScore
+	}
+	return &passage.Passage{
+		Score:   score,
+		Content: result.Passage,
+	}
+}
+
+func (s *Service) retrieve(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passagev2.Filter,
+	mode passagev2.RetrievalMode,
+) ([]RetrieveResult, error) {
+	mode = s.mode(mode)
+	params := RetrieveParams{
+		Mode:   mode,
+		Query:  query,
+		Limit:  s.candidates(limit),
+		Filter: filter,
+	}
+	if needsEmbedding(mode) {
+		vectors, err := s.Embedder.Embed(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to embed query: %w", err)
+		}
+		params.Vectors = vectors
+	}
+
+	results, err := s.search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rerank(ctx, query, results, limit)
+}
+
+// 쿼리 임베딩이 필요한 검색 방식인지 확인합니다. 희소 벡터 검색은 Qdrant가 쿼리 원문으로 희소 벡터를 만듭니다.
+func needsEmbedding(mode passagev2.RetrievalMode) bool {
+	return mode != passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE
+}
+
+func (s *Service) search(ctx context.Context, params RetrieveParams) ([]RetrieveResult, error) {
+	results, err := s.VectorRetriever.Retrieve(ctx, params)
+	if errors.Is(err, ErrInvalidFilter) {
+		return nil, retrieval.InvalidArgument("filter", err.Error())
+	} else if errors.Is(err, ErrUnsupportedMode) {
+		return nil, retrieval.InvalidArgument("mode", err.Error())
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to search passages: %w", err)
+	}
+
+	return results, nil
+}
+
+// v1 조건을 v2 조건으로 바꿉니다. 두 메시지는 필드 번호와 타입이 같으므로 직렬화한 값을 그대로 읽을 수 있다.
+func toFilterV2(filter *passage.Filter) (*passagev2.Filter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	data, err := proto.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &passagev2.Filter{}
+	if err := proto.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
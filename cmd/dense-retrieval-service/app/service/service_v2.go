@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/server"
+)
+
+var _ server.ServiceV2 = (*Service)(nil)
+
+// 패시지 필드로 옮기는 payload 필드 이름.
+const (
+	keyField   = "key"
+	titleField = "title"
+	chunkField = "chunk"
+	urlField   = "url"
+)
+
+// 패시지 내용을 저장하는 payload 필드 이름. 색인한 도구마다 이름이 달라서 앞에서부터 찾는다.
+var textFields = []string{"value", "content", "text"}
+
+func (s *Service) RetrieveV2(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passage.Filter,
+	mode passage.RetrievalMode,
+) ([]*passage.Passage, error) {
+	results, err := s.retrieve(ctx, query, limit, filter, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	passages := make([]*passage.Passage, 0, len(results))
+	for _, result := range results {
+		passages = append(passages, toPassage(result))
+	}
+
+	return passages, nil
+}
+
+// 임베딩이 필요한 쿼리의 임베딩을 한 번에 생성하고, 한 번의 요청으로 검색합니다.
+// 조건이 잘못된 요청이나 순위를 다시 매기지 못한 요청은 해당 결과의 Error에 담고, 임베딩이나 검색 요청 자체가 실패하면 오류를 반환합니다.
+// 요청 수, 쿼리, 결과 개수는 Validator가 확인합니다.
+func (s *Service) BatchRetrieveV2(ctx context.Context, requests []*passage.RetrieveRequest) ([]*passage.BatchRetrieveResult, error) {
+	params := make([]RetrieveParams, len(requests))
+	var (
+		queries []string
+		indexes []int
+	)
+	for i, req := range requests {
+		params[i] = RetrieveParams{
+			Mode:   s.mode(req.GetMode()),
+			Query:  req.GetQuery(),
+			Limit:  s.candidates(req.GetLimit()),
+			Filter: req.GetFilter(),
+		}
+		if needsEmbedding(params[i].Mode) {
+			queries = append(queries, req.GetQuery())
+			indexes = append(indexes, i)
+		}
+	}
+
+	if len(queries) > 0 {
+		vectors, err := s.Embedder.EmbedBatch(ctx, queries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to embed queries: %w", err)
+		}
+		for i, idx := range indexes {
+			params[idx].Vectors = vectors[i]
+		}
+	}
+
+	batch, err := s.VectorRetriever.RetrieveBatch(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search passages: %w", err)
+	}
+
+	results := make([]*passage.BatchRetrieveResult, len(requests))
+	for i, r := range batch {
+		if errors.Is(r.Err, ErrInvalidFilter) {
+			results[i] = &passage.BatchRetrieveResult{Error: toError(retrieval.InvalidArgument("filter", r.Err.Error()))}
+			continue
+		} else if errors.Is(r.Err, ErrUnsupportedMode) {
+			results[i] = &passage.BatchRetrieveResult{Error: toError(retrieval.InvalidArgument("mode", r.Err.Error()))}
+			continue
+		} else if r.Err != nil {
+			results[i] = &passage.BatchRetrieveResult{Error: toError(r.Err)}
+			continue
+		}
+
+		reranked, err := s.rerank(ctx, requests[i].GetQuery(), r.Results, requests[i].GetLimit())
+		if err != nil {
+			results[i] = &passage.BatchRetrieveResult{Error: toError(err)}
+			continue
+		}
+
+		passages := make([]*passage.Passage, 0, len(reranked))
+		for _, result := range reranked {
+			passages = append(passages, toPassage(result))
+		}
+		results[i] = &passage.BatchRetrieveResult{Passages: passages}
+	}
+
+	return results, nil
+}
+
+func toError(err error) *passage.Error {
+	st := status.Convert(retrieval.ToStatus(err))
+	return &passage.Error{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+}
+
+// payload의 알려진 필드는 패시지 필드로 옮기고, 나머지는 메타데이터로 반환합니다.
+func toPassage(result RetrieveResult) *passage.Passage {
+	metadata := make(map[string]*structpb.Value, len(result.Payload))
+	for k, v := range result.Payload {
+		metadata[k] = v
+	}
+
+	take := func(field string) *structpb.Value {
+		v, ok := metadata[field]
+		if ok {
+			delete(metadata, field)
+		}
+		return v
+	}
+
+	p := &passage.Passage{
+		Id:          result.ID,
+		Score:       result.Score,
+		DocumentKey: take(keyField).GetStringValue(),
+		Title:       take(titleField).GetStringValue(),
+		ChunkIndex:  int32(take(chunkField).GetNumberValue()),
+		SourceUrl:   take(urlField).GetStringValue(),
+		Metadata:    &structpb.Struct{Fields: metadata},
+	}
+	if result.RerankScore != nil {
+		p.RerankScore = wrapperspb.Float(*result.RerankScore)
+	}
+	for _, field := range textFields {
+		if _, ok := metadata[field]; ok {
+			p.Text = take(field).GetStringValue()
+			break
+		}
+	}
+
+	return p
+}
+
+// reranker에 전달할 패시지 본문. 본문 필드가 없으면 제목을 사용합니다.
+func passageText(result RetrieveResult) string {
+	for _, field := range textFields {
+		if v, ok := result.Payload[field]; ok {
+			return v.GetStringValue()
+		}
+	}
+	return result.Payload[titleField].GetStringValue()
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/server"
+)
+
+// 패시지 검색 서비스의 모든 API.
+type PassageService interface {
+	server.ServiceV1
+	server.ServiceV2
+}
+
+var _ PassageService = (*Validator)(nil)
+
+type validatorOptions struct {
+	// limit이 0일 때 사용하는 결과 개수.
+	defaultLimit int32
+	// 최대 결과 개수. 더 큰 limit은 이 값으로 줄입니다.
+	maxLimit int32
+	// 쿼리의 최대 글자 수.
+	maxQueryLength int
+	// 한 번의 일괄 검색에서 처리하는 최대 쿼리 수.
+	maxBatchSize int
+	// 검색 방식을 지정하지 않은 요청의 검색 방식.
+	defaultMode passagev2.RetrievalMode
+}
+
+var defaultValidatorOptions = validatorOptions{
+	defaultLimit:   10,
+	maxLimit:       100,
+	maxQueryLength: 1000,
+	maxBatchSize:   32,
+	defaultMode:    passagev2.RetrievalMode_RETRIEVAL_MODE_DENSE,
+}
+
+type ValidatorOption func(*validatorOptions)
+
+func WithDefaultLimit(limit int32) ValidatorOption {
+	return func(opt *validatorOptions) {
+		opt.defaultLimit = limit
+	}
+}
+
+func WithMaxLimit(limit int32) ValidatorOption {
+	return func(opt *validatorOptions) {
+		opt.maxLimit = limit
+	}
+}
+
+func WithMaxQueryLength(length int) ValidatorOption {
+	return func(opt *validatorOptions) {
+		opt.maxQueryLength = length
+	}
+}
+
+func WithMaxBatchSize(size int) ValidatorOption {
+	return func(opt *validatorOptions) {
+		opt.maxBatchSize = size
+	}
+}
+
+func WithDefaultMode(mode passagev2.RetrievalMode) ValidatorOption {
+	return func(opt *validatorOptions) {
+		opt.defaultMode = mode
+	}
+}
+
+// 요청을 확인하고 결과 개수를 보정해서 next에 전달하는 서비스.
+// 쿼리가 비었거나 너무 길거나 limit이 음수이거나 검색 방식을 알 수 없으면 잘못된 필드를 모두 담은 retrieval.InvalidArgumentError를 반환합니다.
+// limit이 0이면 기본 개수를, 최대 개수보다 크면 최대 개수를 사용하고, 검색 방식이 없으면 기본 방식을 사용합니다.
+type Validator struct {
+	next    PassageService
+	options *validatorOptions
+}
+
+func NewValidator(next PassageService, opts ...ValidatorOption) *Validator {
+	options := defaultValidatorOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &Validator{
+		next:    next,
+		options: &options,
+	}
+}
+
+func (v *Validator) Retrieve(ctx context.Context, query string, limit int32, filter *passage.Filter) ([]*passage.Passage, error) {
+	limit, _, err := v.check("", query, limit, passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+	return v.next.Retrieve(ctx, query, limit, filter)
+}
+
+func (v *Validator) RetrieveStream(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passage.Filter,
+	send func(*passage.Passage) error,
+) error {
+	limit, _, err := v.check("", query, limit, passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED)
+	if err != nil {
+		return err
+	}
+	return v.next.RetrieveStream(ctx, query, limit, filter, send)
+}
+
+func (v *Validator) RetrieveV2(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passagev2.Filter,
+	mode passagev2.RetrievalMode,
+) ([]*passagev2.Passage, error) {
+	limit, mode, err := v.check("", query, limit, mode)
+	if err != nil {
+		return nil, err
+	}
+	return v.next.RetrieveV2(ctx, query, limit, filter, mode)
+}
+
+// 잘못된 요청은 해당 결과의 Error에 담고, 나머지 요청만 next에 전달합니다.
+// 잘못된 필드는 요청 목록에서의 위치를 붙여서 알려줍니다. e.g., "requests[1].query"
+func (v *Validator) BatchRetrieveV2(ctx context.Context, requests []*passagev2.RetrieveRequest) ([]*passagev2.BatchRetrieveResult, error) {
+	if len(requests) == 0 {
+		return nil, retrieval.InvalidArgument("requests", "must not be empty")
+	}
+	if len(requests) > v.options.maxBatchSize {
+		return nil, retrieval.InvalidArgument("requests", fmt.Sprintf("must not exceed %d requests", v.options.maxBatchSize))
+	}
+
+	results := make([]*passagev2.BatchRetrieveResult, len(requests))
+	valid := make([]*passagev2.RetrieveRequest, 0, len(requests))
+	indexes := make([]int, 0, len(requests))
+	for i, req := range requests {
+		limit, mode, err := v.check(fmt.Sprintf("requests[%d].", i), req.GetQuery(), req.GetLimit(), req.GetMode())
+		if err != nil {
+			results[i] = &passagev2.BatchRetrieveResult{Error: toError(err)}
+			continue
+		}
+		valid = append(valid, &passagev2.RetrieveRequest{
+			Query:  req.GetQuery(),
+			Limit:  limit,
+			Filter: req.GetFilter(),
+			Mode:   mode,
+		})
+		indexes = append(indexes, i)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	batch, err := v.next.BatchRetrieveV2(ctx, valid)
+	if err != nil {
+		return nil, err
+	}
+	for i, idx := range indexes {
+		results[idx] = batch[i]
+	}
+
+	return results, nil
+}
+
+// 쿼리, limit, 검색 방식을 확인하고 보정한 limit과 검색 방식을 반환합니다. 잘못된 필드 이름 앞에는 prefix를 붙입니다.
+func (v *Validator) check(prefix, query string, limit int32, mode passagev2.RetrievalMode) (int32, passagev2.RetrievalMode, error) {
+	var violations []retrieval.FieldViolation
+	if strings.TrimSpace(query) == "" {
+		violations = append(violations, retrieval.FieldViolation{Field: prefix + "query", Description: "must not be empty"})
+	} else if n := utf8.RuneCountInString(query); n > v.options.maxQueryLength {
+		violations = append(violations, retrieval.FieldViolation{
+			Field:       prefix + "query",
+			Description: fmt.Sprintf("must not exceed %d characters (got %d)", v.options.maxQueryLength, n),
+		})
+	}
+	if limit < 0 {
+		violations = append(violations, retrieval.FieldViolation{Field: prefix + "limit", Description: "must not be negative"})
+	}
+	if _, ok := passagev2.RetrievalMode_name[int32(mode)]; !ok {
+		violations = append(violations, retrieval.FieldViolation{Field: prefix + "mode", Description: fmt.Sprintf("unknown retrieval mode %d", mode)})
+	}
+	if len(violations) > 0 {
+		return 0, 0, &retrieval.InvalidArgumentError{Violations: violations}
+	}
+
+	if mode == passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED {
+		mode = v.options.defaultMode
+	}
+	if limit == 0 {
+		return v.options.defaultLimit, mode, nil
+	}
+	return min(limit, v.options.maxLimit), mode, nil
+}
@@ -0,0 +1,329 @@
+package service_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/devafterdark/project-lumos/cmd/dense-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+)
+
+// 전달받은 요청을 기록하는 PassageService.
+type fakePassageService struct {
+	requests []*passagev2.RetrieveRequest
+}
+
+func (f *fakePassageService) Retrieve(ctx context.Context, query string, limit int32, filter *passage.Filter) ([]*passage.Passage, error) {
+	f.requests = append(f.requests, &passagev2.RetrieveRequest{Query: query, Limit: limit})
+	return nil, nil
+}
+
+func (f *fakePassageService) RetrieveStream(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passage.Filter,
+	send func(*passage.Passage) error,
+) error {
+	f.requests = append(f.requests, &passagev2.RetrieveRequest{Query: query, Limit: limit})
+	return nil
+}
+
+func (f *fakePassageService) RetrieveV2(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passagev2.Filter,
+	mode passagev2.RetrievalMode,
+) ([]*passagev2.Passage, error) {
+	f.requests = append(f.requests, &passagev2.RetrieveRequest{Query: query, Limit: limit, Mode: mode})
+	return nil, nil
+}
+
+func (f *fakePassageService) BatchRetrieveV2(ctx context.Context, requests []*passagev2.RetrieveRequest) ([]*passagev2.BatchRetrieveResult, error) {
+	f.requests = append(f.requests, requests...)
+	results := make([]*passagev2.BatchRetrieveResult, len(requests))
+	for i := range requests {
+		results[i] = &passagev2.BatchRetrieveResult{}
+	}
+	return results, nil
+}
+
+// 오류의 InvalidArgument 상태에서 잘못된 필드 목록을 꺼냅니다.
+func fieldViolations(t *testing.T, err error) []string {
+	t.Helper()
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected %v, got %v", codes.InvalidArgument, st.Code())
+	}
+	var fields []string
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func newValidator(next *fakePassageService) *service.Validator {
+	return service.NewValidator(next,
+		service.WithDefaultLimit(10),
+		service.WithMaxLimit(50),
+		service.WithMaxQueryLength(5),
+		service.WithMaxBatchSize(3),
+		service.WithDefaultMode(passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID),
+	)
+}
+
+func TestValidatorRetrieveV2(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		query            string
+		limit            int32
+		mode             passagev2.RetrievalMode
+		expectViolations []string
+		expectLimit      int32
+		expectMode       passagev2.RetrievalMode
+	}{
+		{
+			desc:        "keep valid limit and mode",
+			query:       "query",
+			limit:       5,
+			mode:        passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE,
+			expectLimit: 5,
+			expectMode:  passagev2.RetrievalMode_RETRIEVAL_MODE_SPARSE,
+		},
+		{
+			desc:        "zero limit uses default",
+			query:       "query",
+			expectLimit: 10,
+			expectMode:  passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+		},
+		{
+			desc:        "clamp limit to max",
+			query:       "query",
+			limit:       1000,
+			expectLimit: 50,
+			expectMode:  passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+		},
+		{
+			desc:        "count characters not bytes",
+			query:       "검색 질문",
+			expectLimit: 10,
+			expectMode:  passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+		},
+		{
+			desc:             "negative limit",
+			query:            "query",
+			limit:            -1,
+			expectViolations: []string{"limit"},
+		},
+		{
+			desc:             "empty query",
+			query:            "",
+			expectViolations: []string{"query"},
+		},
+		{
+			desc:             "whitespace query",
+			query:            " \t\n",
+			expectViolations: []string{"query"},
+		},
+		{
+			desc:             "oversized query",
+			query:            "query!",
+			expectViolations: []string{"query"},
+		},
+		{
+			desc:             "unknown mode",
+			query:            "query",
+			mode:             passagev2.RetrievalMode(99),
+			expectViolations: []string{"mode"},
+		},
+		{
+			desc:             "report all violations",
+			query:            "",
+			limit:            -1,
+			mode:             passagev2.RetrievalMode(99),
+			expectViolations: []string{"query", "limit", "mode"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			next := &fakePassageService{}
+			_, err := newValidator(next).RetrieveV2(context.Background(), tc.query, tc.limit, nil, tc.mode)
+
+			if len(tc.expectViolations) > 0 {
+				if got := fieldViolations(t, err); !slices.Equal(got, tc.expectViolations) {
+					t.Errorf("expected violations %v, got %v", tc.expectViolations, got)
+				}
+				if len(next.requests) != 0 {
+					t.Errorf("expected no requests to next, got %d", len(next.requests))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to retrieve: %v", err)
+			}
+
+			if len(next.requests) != 1 {
+				t.Fatalf("expected 1 request to next, got %d", len(next.requests))
+			}
+			if got := next.requests[0]; got.GetLimit() != tc.expectLimit || got.GetMode() != tc.expectMode {
+				t.Errorf("expected limit %d mode %v, got limit %d mode %v", tc.expectLimit, tc.expectMode, got.GetLimit(), got.GetMode())
+			}
+		})
+	}
+}
+
+func TestValidatorRetrieve(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		query            string
+		limit            int32
+		expectViolations []string
+		expectLimit      int32
+	}{
+		{
+			desc:        "zero limit uses default",
+			query:       "query",
+			expectLimit: 10,
+		},
+		{
+			desc:        "clamp limit to max",
+			query:       "query",
+			limit:       51,
+			expectLimit: 50,
+		},
+		{
+			desc:             "invalid query and limit",
+			query:            " ",
+			limit:            -5,
+			expectViolations: []string{"query", "limit"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			next := &fakePassageService{}
+			v := newValidator(next)
+
+			_, err := v.Retrieve(context.Background(), tc.query, tc.limit, nil)
+			streamErr := v.RetrieveStream(context.Background(), tc.query, tc.limit, nil, func(*passage.Passage) error { return nil })
+
+			if len(tc.expectViolations) > 0 {
+				for _, err := range []error{err, streamErr} {
+					if got := fieldViolations(t, err); !slices.Equal(got, tc.expectViolations) {
+						t.Errorf("expected violations %v, got %v", tc.expectViolations, got)
+					}
+				}
+				return
+			}
+			if err != nil || streamErr != nil {
+				t.Fatalf("failed to retrieve: %v, %v", err, streamErr)
+			}
+
+			for _, req := range next.requests {
+				if req.GetLimit() != tc.expectLimit {
+					t.Errorf("expected limit %d, got %d", tc.expectLimit, req.GetLimit())
+				}
+			}
+		})
+	}
+}
+
+func TestValidatorBatchRetrieveV2(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		requests         []*passagev2.RetrieveRequest
+		expectViolations []string
+		// 요청별 오류 메시지에 포함되어야 하는 필드 경로. 비어 있으면 성공해야 합니다.
+		expectErrors []string
+		expectLimits []int32
+	}{
+		{
+			desc:             "empty batch",
+			expectViolations: []string{"requests"},
+		},
+		{
+			desc: "too many requests",
+			requests: []*passagev2.RetrieveRequest{
+				{Query: "a"}, {Query: "b"}, {Query: "c"}, {Query: "d"},
+			},
+			expectViolations: []string{"requests"},
+		},
+		{
+			desc: "per-item violations with field paths",
+			requests: []*passagev2.RetrieveRequest{
+				{Query: "a", Limit: 100},
+				{Query: "  ", Limit: -1},
+				{Query: "b", Mode: passagev2.RetrievalMode(99)},
+			},
+			expectErrors: []string{"", "requests[1].query, requests[1].limit", "requests[2].mode"},
+			expectLimits: []int32{50},
+		},
+		{
+			desc: "all invalid",
+			requests: []*passagev2.RetrieveRequest{
+				{Query: "too long"},
+			},
+			expectErrors: []string{"requests[0].query"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			next := &fakePassageService{}
+			results, err := newValidator(next).BatchRetrieveV2(context.Background(), tc.requests)
+
+			if len(tc.expectViolations) > 0 {
+				if got := fieldViolations(t, err); !slices.Equal(got, tc.expectViolations) {
+					t.Errorf("expected violations %v, got %v", tc.expectViolations, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to retrieve: %v", err)
+			}
+
+			if len(results) != len(tc.expectErrors) {
+				t.Fatalf("expected %d results, got %d", len(tc.expectErrors), len(results))
+			}
+			for i, want := range tc.expectErrors {
+				got := results[i].GetError()
+				if want == "" {
+					if got != nil {
+						t.Errorf("expected result %d to succeed, got %v", i, got)
+					}
+					continue
+				}
+				if got.GetCode() != int32(codes.InvalidArgument) {
+					t.Errorf("expected result %d code %d, got %d", i, codes.InvalidArgument, got.GetCode())
+				}
+				for _, field := range strings.Split(want, ", ") {
+					if !strings.Contains(got.GetMessage(), field+":") {
+						t.Errorf("expected result %d message to contain %q, got %q", i, field, got.GetMessage())
+					}
+				}
+			}
+
+			var limits []int32
+			for _, req := range next.requests {
+				limits = append(limits, req.GetLimit())
+			}
+			if !slices.Equal(limits, tc.expectLimits) {
+				t.Errorf("expected limits %v sent to next, got %v", tc.expectLimits, limits)
+			}
+		})
+	}
+}
@@ -10,8 +10,25 @@ import (
 func main() {
 	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
 
+	cfg, printConfig, err := app.LoadConfig(os.Args[1:])
+	if err != nil {
+		slog.Error("invalid configuration", slog.Any("error", err))
+		os.Exit(2)
+	}
+	if printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			slog.Error("failed to print configuration", slog.Any("error", err))
+			os.Exit(1)
+		}
+		if err := cfg.Validate(); err != nil {
+			slog.Error("invalid configuration", slog.Any("error", err))
+			os.Exit(2)
+		}
+		return
+	}
+
 	slog.Info("Dense Retrieval Service starting")
-	if err := app.Run(); err != nil {
+	if err := app.Run(cfg); err != nil {
 		slog.Error("failed to run dense retrieval service", slog.Any("error", err))
 	}
 	slog.Info("Dense Retrieval Service finished")
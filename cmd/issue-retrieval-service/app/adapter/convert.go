@@ -0,0 +1,57 @@
+package adapter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+	"github.com/devafterdark/project-lumos/pkg/jira/markup"
+)
+
+// 수집기가 기록한 문서. Jira 이슈와 같은 형식이며, 수집 원본이 source에 기록됩니다.
+type document struct {
+	jira.Issue
+	// 수집 원본. e.g., "jira", "confluence"
+	Source string `json:"source"`
+}
+
+// Jira 이슈가 아닌 문서인지 확인합니다.
+func (d *document) external() bool {
+	return d.Source != "" && d.Source != "jira"
+}
+
+// Jira 이슈를 서비스 응답 형식으로 변환합니다. jiraURL은 이슈 웹 주소를 만들 때 사용하며 비어 있을 수 있습니다.
+// 위키 마크업은 일반 텍스트로 변환합니다.
+func toIssue(doc *document, jiraURL string) *issue.Issue {
+	comments := make([]string, 0, len(doc.Fields.CommentInfo.Comments))
+	for _, c := range doc.Fields.CommentInfo.Comments {
+		comments = append(comments, markup.ToText(c.Body))
+	}
+
+	var url string
+	if jiraURL != "" {
+		url = jiraURL + "/browse/" + doc.Key
+	}
+
+	return &issue.Issue{
+		Key:      doc.Key,
+		Title:    doc.Fields.Title,
+		Content:  markup.ToText(doc.Fields.Content),
+		Comments: comments,
+		Status:   doc.Fields.Status.Name,
+		Labels:   doc.Fields.Labels,
+		Assignee: doc.Fields.Assignee.Name,
+		Url:      url,
+		Created:  timestamp(doc.Fields.Created),
+		Updated:  timestamp(doc.Fields.Updated),
+	}
+}
+
+// Jira 날짜 문자열을 변환합니다. 비어 있거나 해석할 수 없으면 nil을 반환합니다.
+func timestamp(s string) *timestamppb.Timestamp {
+	t, err := jira.ParseTime(s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
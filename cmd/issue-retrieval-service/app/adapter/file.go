@@ -0,0 +1,184 @@
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+)
+
+var (
+	_ service.IssueStore = (*FileStore)(nil)
+	_ service.IssueIndex = (*FileStore)(nil)
+)
+
+// 수집기 출력 디렉토리의 이슈를 메모리에 올려 두고 조회하는 저장소.
+// Reload를 호출하거나 Watch로 파일 변경을 감시하면 수집기가 새로 기록한 이슈를 다시 읽습니다.
+type FileStore struct {
+	dir     string
+	jiraURL string
+
+	// 마지막으로 읽은 이슈. 다시 읽는 동안에도 조회할 수 있도록 새로 읽은 뒤 한 번에 바꿉니다.
+	snapshot atomic.Pointer[fileSnapshot]
+}
+
+// 한 번에 읽은 디렉토리의 이슈.
+type fileSnapshot struct {
+	// 파일에서 읽은 순서대로 저장한 이슈.
+	entries []*entry
+	byKey   map[string]*entry
+	// 읽지 않은 Jira 이슈가 아닌 문서 수.
+	skipped int
+	// 읽은 파일의 경로, 크기, 수정 시각으로 계산한 값. 파일이 바뀌었는지 확인할 때 사용합니다.
+	version uint64
+}
+
+// dir 아래의 모든 JSON Lines 파일(*.jsonl)에서 Jira 이슈를 읽습니다. e.g., output/AA/issues.jsonl
+// Confluence 페이지처럼 source가 jira가 아닌 문서는 건너뜁니다. jiraURL은 이슈 웹 주소를 만들 때 사용하며 비어 있을 수 있습니다.
+func NewFileStore(dir, jiraURL string) (*FileStore, error) {
+	s := &FileStore{dir: dir, jiraURL: strings.TrimSuffix(jiraURL, "/")}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// 디렉토리의 이슈를 다시 읽습니다. 실패하면 이전에 읽은 이슈를 그대로 사용합니다.
+func (s *FileStore) Reload() error {
+	version, err := s.version()
+	if err != nil {
+		return err
+	}
+
+	snapshot := &fileSnapshot{byKey: make(map[string]*entry), version: version}
+	err = s.walk(func(path string, d fs.DirEntry) error {
+		return snapshot.load(path, s.jiraURL)
+	})
+	if err != nil {
+		return err
+	}
+	s.snapshot.Store(snapshot)
+
+	slog.Info("loaded issues", slog.String("dir", s.dir), slog.Int("count", len(snapshot.entries)), slog.Int("skipped", snapshot.skipped))
+	return nil
+}
+
+// interval마다 파일의 크기와 수정 시각을 확인해서, 바뀐 파일이 있으면 이슈를 다시 읽습니다. ctx가 끝날 때까지 반환하지 않습니다.
+// 수집기는 수집을 마친 뒤 파일 이름을 바꿔서 결과를 저장하므로 기록 중인 파일을 읽지 않습니다.
+func (s *FileStore) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		version, err := s.version()
+		if err != nil {
+			slog.Warn("failed to check issue files", slog.String("dir", s.dir), slog.Any("error", err))
+			continue
+		}
+		if version == s.snapshot.Load().version {
+			continue
+		}
+		if err := s.Reload(); err != nil {
+			slog.Warn("failed to reload issues", slog.String("dir", s.dir), slog.Any("error", err))
+		}
+	}
+}
+
+// 디렉토리 아래의 모든 JSON Lines 파일에 fn을 호출합니다.
+func (s *FileStore) walk(fn func(path string, d fs.DirEntry) error) error {
+	return filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".jsonl") {
+			return nil
+		}
+		return fn(path, d)
+	})
+}
+
+// JSON Lines 파일의 경로, 크기, 수정 시각으로 계산한 값.
+func (s *FileStore) version() (uint64, error) {
+	h := fnv.New64a()
+	err := s.walk(func(path string, d fs.DirEntry) error {
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00%d\x00%d\x00", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return h.Sum64(), nil
+}
+
+func (s *fileSnapshot) load(path, jiraURL string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var doc document
+		if err := json.Unmarshal(scanner.Bytes(), &doc); err != nil {
+			return fmt.Errorf("failed to parse %s:%d: %w", path, line, err)
+		}
+		if doc.Key == "" {
+			continue
+		}
+		// Confluence 페이지처럼 Jira 이슈가 아닌 문서는 이슈로 반환하지 않는다.
+		if doc.external() {
+			s.skipped++
+			continue
+		}
+
+		e := newEntry(&doc, toIssue(&doc, jiraURL))
+		// 같은 키가 여러 파일에 있으면 나중에 읽은 이슈를 사용한다.
+		if old, ok := s.byKey[doc.Key]; ok {
+			*old = *e
+			continue
+		}
+		s.byKey[doc.Key] = e
+		s.entries = append(s.entries, e)
+	}
+	return scanner.Err()
+}
+
+func (s *FileStore) Get(ctx context.Context, key string) (*issue.Issue, error) {
+	e, ok := s.snapshot.Load().byKey[key]
+	if !ok {
+		return nil, service.ErrNotFound
+	}
+	return e.issue, nil
+}
+
+func (s *FileStore) Search(ctx context.Context, params service.SearchParams) ([]*issue.Issue, int, error) {
+	issues, total := search(s.snapshot.Load().entries, params)
+	return issues, total, nil
+}
@@ -0,0 +1,188 @@
+package adapter_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/adapter"
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/service"
+)
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"AA/issues.jsonl":             "{\"key\":\"AA-1\",\"fields\":{\"summary\":\"issue\",\"description\":\"*bold*\"}}\n{\"key\":\"AA-2\",\"source\":\"jira\",\"fields\":{\"summary\":\"issue\"}}\n",
+		"confluence/DEV/pages.jsonl":  "{\"key\":\"confluence:123\",\"source\":\"confluence\",\"url\":\"https://wiki.example.com/pages/123\",\"fields\":{\"summary\":\"page\"}}\n",
+		"confluence/DEV/ignored.json": "not json lines",
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	store, err := adapter.NewFileStore(dir, "https://jira.example.com/")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	testCases := []struct {
+		desc      string
+		key       string
+		expectErr error
+		expectURL string
+	}{
+		{
+			desc:      "issue without source",
+			key:       "AA-1",
+			expectURL: "https://jira.example.com/browse/AA-1",
+		},
+		{
+			desc:      "jira issue",
+			key:       "AA-2",
+			expectURL: "https://jira.example.com/browse/AA-2",
+		},
+		{
+			desc:      "confluence page",
+			key:       "confluence:123",
+			expectErr: service.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := store.Get(context.Background(), tc.key)
+			if tc.expectErr != nil {
+				if !errors.Is(err, tc.expectErr) {
+					t.Errorf("expected %v, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get issue: %v", err)
+			}
+			if got.Url != tc.expectURL {
+				t.Errorf("expected url %q, got %q", tc.expectURL, got.Url)
+			}
+		})
+	}
+
+	_, total, err := store.Search(context.Background(), service.SearchParams{Limit: 10})
+	if err != nil {
+		t.Fatalf("failed to search: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("expected 2 issues, got %d", total)
+	}
+}
+
+func TestFileStoreReload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "issues.jsonl")
+	write := func(data string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+	write("{\"key\":\"AA-1\",\"fields\":{\"summary\":\"old\"}}\n")
+
+	store, err := adapter.NewFileStore(dir, "")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	testCases := []struct {
+		desc        string
+		data        string
+		expectErr   bool
+		expectTitle string
+		expectTotal int
+	}{
+		{
+			desc:        "updated and new issues",
+			data:        "{\"key\":\"AA-1\",\"fields\":{\"summary\":\"new\"}}\n{\"key\":\"AA-2\",\"fields\":{\"summary\":\"issue\"}}\n",
+			expectTitle: "new",
+			expectTotal: 2,
+		},
+		{
+			desc:        "keep previous issues on parse error",
+			data:        "not json\n",
+			expectErr:   true,
+			expectTitle: "new",
+			expectTotal: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			write(tc.data)
+
+			err := store.Reload()
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectErr, err)
+			}
+
+			got, err := store.Get(context.Background(), "AA-1")
+			if err != nil {
+				t.Fatalf("failed to get issue: %v", err)
+			}
+			if got.Title != tc.expectTitle {
+				t.Errorf("expected title %q, got %q", tc.expectTitle, got.Title)
+			}
+
+			_, total, err := store.Search(context.Background(), service.SearchParams{Limit: 10})
+			if err != nil {
+				t.Fatalf("failed to search: %v", err)
+			}
+			if total != tc.expectTotal {
+				t.Errorf("expected %d issues, got %d", tc.expectTotal, total)
+			}
+		})
+	}
+}
+
+func TestFileStoreWatch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "issues.jsonl")
+	if err := os.WriteFile(path, []byte("{\"key\":\"AA-1\",\"fields\":{}}\n"), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	store, err := adapter.NewFileStore(dir, "")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go store.Watch(ctx, 10*time.Millisecond)
+
+	// 수집기처럼 새 파일을 만든 뒤 이름을 바꿔서 교체한다.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte("{\"key\":\"AA-1\",\"fields\":{}}\n{\"key\":\"AA-2\",\"fields\":{}}\n"), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatalf("failed to rename file: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := store.Get(context.Background(), "AA-2"); err == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected AA-2 to be loaded after the file changed, got not found")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
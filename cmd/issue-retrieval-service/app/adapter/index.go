@@ -0,0 +1,140 @@
+package adapter
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+// 제목에 포함된 검색어의 가중치. 본문과 댓글은 1입니다.
+const titleWeight = 3
+
+// 검색할 이슈. 검색 조건 비교에 필요한 값을 미리 계산해 둡니다.
+type entry struct {
+	issue *issue.Issue
+
+	// 프로젝트 키. Jira 이슈가 아니면 비어 있습니다.
+	project  string
+	assignee jira.User
+	created  time.Time
+	updated  time.Time
+
+	// 소문자로 바꾼 검색 대상 텍스트.
+	title    string
+	content  string
+	comments string
+}
+
+func newEntry(doc *document, i *issue.Issue) *entry {
+	e := &entry{
+		issue:    i,
+		assignee: doc.Fields.Assignee,
+		title:    strings.ToLower(i.Title),
+		content:  strings.ToLower(i.Content),
+		comments: strings.ToLower(strings.Join(i.Comments, "\n")),
+	}
+	if project, _, ok := strings.Cut(doc.Key, "-"); ok {
+		e.project = project
+	}
+	if i.Created != nil {
+		e.created = i.Created.AsTime()
+	}
+	if i.Updated != nil {
+		e.updated = i.Updated.AsTime()
+	}
+	return e
+}
+
+func (e *entry) match(f *service.Filter) bool {
+	if len(f.Projects) > 0 && !containsFold(f.Projects, e.project) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !containsFold(f.Statuses, e.issue.Status) {
+		return false
+	}
+	if len(f.Labels) > 0 && !slices.ContainsFunc(e.issue.Labels, func(l string) bool { return containsFold(f.Labels, l) }) {
+		return false
+	}
+	if len(f.Assignees) > 0 && !containsFold(f.Assignees, e.assignee.ID) &&
+		!containsFold(f.Assignees, e.assignee.Name) && !containsFold(f.Assignees, e.assignee.EmailAddress) {
+		return false
+	}
+	// 날짜 조건이 있으면 날짜를 알 수 없는 이슈는 제외한다.
+	if f.Created != (service.TimeRange{}) && (e.created.IsZero() || !f.Created.Contains(e.created)) {
+		return false
+	}
+	if f.Updated != (service.TimeRange{}) && (e.updated.IsZero() || !f.Updated.Contains(e.updated)) {
+		return false
+	}
+	return true
+}
+
+// 모든 검색어가 제목, 본문, 댓글 중 한 곳에 포함되어야 합니다. 포함되지 않은 검색어가 있으면 0을 반환합니다.
+func (e *entry) score(terms []string) int {
+	score := 0
+	for _, term := range terms {
+		n := titleWeight*strings.Count(e.title, term) + strings.Count(e.content, term) + strings.Count(e.comments, term)
+		if n == 0 {
+			return 0
+		}
+		score += n
+	}
+	return score
+}
+
+// 검색 결과를 정렬해서 요청한 범위만 반환합니다.
+// 점수나 수정일이 같으면 키 순서로 정렬해서 페이지를 나눠 조회해도 결과가 겹치지 않습니다.
+func search(entries []*entry, params service.SearchParams) ([]*issue.Issue, int) {
+	terms := strings.FieldsFunc(strings.ToLower(params.Text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	type hit struct {
+		entry *entry
+		score int
+	}
+	var hits []hit
+	for _, e := range entries {
+		if !e.match(&params.Filter) {
+			continue
+		}
+		score := 0
+		if len(terms) > 0 {
+			if score = e.score(terms); score == 0 {
+				continue
+			}
+		}
+		hits = append(hits, hit{entry: e, score: score})
+	}
+
+	slices.SortFunc(hits, func(a, b hit) int {
+		return cmp.Or(
+			cmp.Compare(b.score, a.score),
+			b.entry.updated.Compare(a.entry.updated),
+			cmp.Compare(a.entry.issue.Key, b.entry.issue.Key),
+		)
+	})
+
+	total := len(hits)
+	start := min(params.Offset, total)
+	end := min(start+params.Limit, total)
+
+	issues := make([]*issue.Issue, 0, end-start)
+	for _, h := range hits[start:end] {
+		issues = append(issues, h.entry.issue)
+	}
+	return issues, total
+}
+
+func containsFold(values []string, s string) bool {
+	if s == "" {
+		return false
+	}
+	return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, s) })
+}
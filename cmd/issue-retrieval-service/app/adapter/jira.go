@@ -0,0 +1,110 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/retry"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+)
+
+var _ service.IssueStore = (*JiraClient)(nil)
+
+// 응답에 필요한 이슈 필드.
+const jiraFields = "summary,description,comment,status,labels,assignee,created,updated"
+
+// Jira REST API로 이슈를 조회하는 저장소. 수집 결과에 아직 없는 이슈를 조회할 때 사용합니다.
+type JiraClient struct {
+	client *http.Client
+
+	// Jira 기본 URL. e.g., "https://jira.example.com"
+	baseURL string
+	token   string
+}
+
+func NewJiraClient(baseURL, token string) *JiraClient {
+	return &JiraClient{
+		client:  &http.Client{Timeout: 10 * time.Second},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+func (c *JiraClient) Get(ctx context.Context, key string) (*issue.Issue, error) {
+	u := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=%s", c.baseURL, url.PathEscape(key), jiraFields)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, &retrieval.UnavailableError{Service: "jira", Err: err}
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Warn("failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, service.ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return nil, &retrieval.UnavailableError{
+			Service:    "jira",
+			RetryDelay: retry.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Err:        fmt.Errorf("jira: %s", resp.Status),
+		}
+	default:
+		return nil, fmt.Errorf("jira: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return toIssue(&doc, c.baseURL), nil
+}
+
+// Jira 서버 정보를 조회해서 Jira에 연결할 수 있는지 확인합니다.
+func (c *JiraClient) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/rest/api/2/serverInfo", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Warn("failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jira: %s", resp.Status)
+	}
+	return nil
+}
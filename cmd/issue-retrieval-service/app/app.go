@@ -0,0 +1,142 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/adapter"
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/pkg/service/interceptor"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/issue/server"
+	"github.com/devafterdark/project-lumos/pkg/service/vars"
+)
+
+const (
+	defaultDataDir   = "output"
+	defaultCacheSize = 1000
+	defaultCacheTTL  = 10 * time.Minute
+	// 수집기 출력 파일이 바뀌었는지 확인하는 주기.
+	defaultReloadInterval = time.Minute
+)
+
+func Run() error {
+	jiraURL := os.Getenv("JIRA_URL")
+	fileStore, err := adapter.NewFileStore(getenv("ISSUE_DATA_DIR", defaultDataDir), jiraURL)
+	if err != nil {
+		return err
+	}
+	stores := []service.IssueStore{fileStore}
+	opts := []server.Option{}
+
+	// Jira 토큰이 설정되면 수집 결과에 없는 이슈를 Jira에서 조회한다.
+	if token, ok := os.LookupEnv("JIRA_TOKEN"); ok && token != "" {
+		if jiraURL == "" {
+			return errors.New("JIRA_URL is not set")
+		}
+		jira := adapter.NewJiraClient(jiraURL, token)
+		stores = append(stores, jira)
+		opts = append(opts, server.WithHealthCheck("jira", jira.Check))
+	}
+
+	cacheSize := defaultCacheSize
+	if v, ok := os.LookupEnv("CACHE_SIZE"); ok {
+		if cacheSize, err = strconv.Atoi(v); err != nil || cacheSize < 0 {
+			return errors.New("CACHE_SIZE must be a non-negative integer")
+		}
+	}
+
+	cacheTTL := defaultCacheTTL
+	if v, ok := os.LookupEnv("CACHE_TTL"); ok {
+		if cacheTTL, err = time.ParseDuration(v); err != nil || cacheTTL <= 0 {
+			return errors.New("CACHE_TTL must be a positive duration")
+		}
+	}
+
+	reloadInterval := defaultReloadInterval
+	if v, ok := os.LookupEnv("ISSUE_RELOAD_INTERVAL"); ok {
+		if reloadInterval, err = time.ParseDuration(v); err != nil || reloadInterval < 0 {
+			return errors.New("ISSUE_RELOAD_INTERVAL must be a non-negative duration")
+		}
+	}
+
+	svc := service.NewService(fileStore, cacheSize, cacheTTL, stores...)
+
+	opts = append(opts, server.WithServiceV1(svc))
+	metrics := interceptor.NewMetrics()
+	expvar.Publish("grpc", metrics)
+	opts = append(opts, server.WithMetrics(metrics))
+	if certFile := os.Getenv("TLS_CERT_FILE"); certFile != "" {
+		opts = append(opts,
+			server.WithTLS(certFile, os.Getenv("TLS_KEY_FILE")),
+			server.WithClientCA(os.Getenv("TLS_CLIENT_CA_FILE")),
+		)
+	}
+	if tokens := os.Getenv("AUTH_TOKENS"); tokens != "" {
+		opts = append(opts, server.WithTokens(strings.Split(tokens, ",")...))
+	}
+	if os.Getenv("GRPC_REFLECTION") == "true" {
+		opts = append(opts, server.WithReflection())
+	}
+	s := server.NewServer(opts...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	if addr := os.Getenv("METRICS_ADDRESS"); addr != "" {
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			cancel()
+			return fmt.Errorf("failed to listen on %s: %w", addr, err)
+		}
+		go func() {
+			if err := vars.Serve(ctx, listener); err != nil {
+				slog.Warn("metrics server stopped", slog.Any("error", err))
+			}
+		}()
+	}
+
+	// 수집기를 다시 실행한 뒤 서비스를 재시작하지 않아도 새 이슈를 조회할 수 있도록 파일을 다시 읽는다.
+	if reloadInterval > 0 {
+		go fileStore.Watch(ctx, reloadInterval)
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				if err := fileStore.Reload(); err != nil {
+					slog.Warn("failed to reload issues", slog.Any("error", err))
+				}
+			}
+		}
+	}()
+
+	defer metrics.LogSummary()
+	return s.Serve(ctx)
+}
+
+func getenv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
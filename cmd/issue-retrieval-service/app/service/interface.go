@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+)
+
+// 저장소에 이슈가 없을 때 반환하는 오류.
+var ErrNotFound = errors.New("issue not found")
+
+// 이슈 저장소.
+type IssueStore interface {
+	// 키로 이슈를 조회합니다. 이슈가 없으면 ErrNotFound를 반환합니다.
+	Get(ctx context.Context, key string) (*issue.Issue, error)
+}
+
+// 이슈 검색 조건. 비어 있는 조건은 제한하지 않습니다.
+// 목록 조건은 값 중 하나라도 일치하면 만족하며, 이슈는 모든 조건을 만족해야 합니다.
+type Filter struct {
+	Projects  []string
+	Statuses  []string
+	Labels    []string
+	Assignees []string
+	Created   TimeRange
+	Updated   TimeRange
+}
+
+// 시간 범위. Start는 포함하고 End는 포함하지 않습니다. zero time이면 제한하지 않습니다.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// 시간 범위에 t가 포함되는지 확인합니다.
+func (r TimeRange) Contains(t time.Time) bool {
+	if !r.Start.IsZero() && t.Before(r.Start) {
+		return false
+	}
+	if !r.End.IsZero() && !t.Before(r.End) {
+		return false
+	}
+	return true
+}
+
+type SearchParams struct {
+	// 검색어. 비어 있으면 최근 수정한 순서로, 있으면 관련도 순서로 정렬합니다.
+	Text   string
+	Filter Filter
+	Offset int
+	Limit  int
+}
+
+// 이슈 검색 색인.
+type IssueIndex interface {
+	// 조건에 맞는 이슈를 Offset부터 Limit개 반환하고, 조건에 맞는 전체 이슈 수를 함께 반환합니다.
+	Search(ctx context.Context, params SearchParams) ([]*issue.Issue, int, error)
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// 페이지 토큰은 다음 페이지의 시작 위치와 검색 조건의 해시로 만든다.
+// 조건이 다른 요청에 토큰을 사용하면 엉뚱한 위치부터 조회하게 되므로 해시로 확인한다.
+func encodePageToken(offset int, fingerprint string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + ":" + fingerprint))
+}
+
+func decodePageToken(token, fingerprint string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("malformed page token: %w", err)
+	}
+
+	offset, hash, ok := strings.Cut(string(data), ":")
+	if !ok || hash != fingerprint {
+		return 0, fmt.Errorf("page token does not match the request")
+	}
+
+	n, err := strconv.Atoi(offset)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("malformed page token")
+	}
+	return n, nil
+}
+
+// 검색어와 검색 조건의 해시.
+func fingerprint(query string, filter *issue.IssueFilter) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil)[:8]), nil
+}
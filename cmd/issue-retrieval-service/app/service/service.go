@@ -0,0 +1,34 @@
+package service
+
+import (
+	"time"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/cache"
+)
+
+type Service struct {
+	// 검색어와 조건으로 이슈를 찾는 색인.
+	Index IssueIndex
+	// 이슈를 조회할 저장소 목록. 앞의 저장소에 없는 이슈만 다음 저장소에서 조회합니다.
+	Stores []IssueStore
+
+	cache *cache.LRU[string, cachedIssue]
+	// 이슈를 캐시에 저장하는 기간. Jira에서 조회한 이슈가 바뀌어도 이 기간이 지나면 다시 조회합니다.
+	cacheTTL time.Duration
+}
+
+type cachedIssue struct {
+	issue     *issue.Issue
+	expiresAt time.Time
+}
+
+// cacheSize개의 이슈를 cacheTTL 동안 캐시하는 서비스를 생성합니다.
+func NewService(index IssueIndex, cacheSize int, cacheTTL time.Duration, stores ...IssueStore) *Service {
+	return &Service{
+		Index:    index,
+		Stores:   stores,
+		cache:    cache.NewLRU[string, cachedIssue](cacheSize),
+		cacheTTL: cacheTTL,
+	}
+}
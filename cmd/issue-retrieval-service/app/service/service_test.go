@@ -0,0 +1,66 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/issue-retrieval-service/app/service"
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+)
+
+// 조회할 때마다 제목이 바뀌는 이슈 저장소.
+type countingStore struct {
+	calls int
+}
+
+func (s *countingStore) Get(ctx context.Context, key string) (*issue.Issue, error) {
+	s.calls++
+	return &issue.Issue{Key: key, Title: time.Now().String()}, nil
+}
+
+func TestRetrieveCache(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		cacheSize int
+		ttl       time.Duration
+		expected  int
+	}{
+		{
+			desc:      "cached issue",
+			cacheSize: 10,
+			ttl:       time.Hour,
+			expected:  1,
+		},
+		{
+			desc:      "expired issue",
+			cacheSize: 10,
+			ttl:       time.Nanosecond,
+			expected:  2,
+		},
+		{
+			desc:      "cache disabled",
+			cacheSize: 0,
+			ttl:       time.Hour,
+			expected:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			store := &countingStore{}
+			svc := service.NewService(nil, tc.cacheSize, tc.ttl, store)
+
+			for range 2 {
+				if _, err := svc.Retrieve(context.Background(), []string{"AA-1"}); err != nil {
+					t.Fatalf("failed to retrieve: %v", err)
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			if store.calls != tc.expected {
+				t.Errorf("expected %d store calls, got %d", tc.expected, store.calls)
+			}
+		})
+	}
+}
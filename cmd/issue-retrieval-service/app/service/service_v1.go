@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/issue/server"
+)
+
+var _ server.ServiceV1 = (*Service)(nil)
+
+// 요청한 이슈를 요청 순서대로 반환합니다. 중복된 키는 한 번만 반환합니다.
+// 하나라도 찾지 못하면 찾지 못한 키를 모두 담은 retrieval.NotFoundError를 반환합니다.
+func (s *Service) Retrieve(ctx context.Context, keys []string) ([]*issue.Issue, error) {
+	issues := make([]*issue.Issue, 0, len(keys))
+	seen := make(map[string]struct{}, len(keys))
+	var missing []string
+
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		found, err := s.get(ctx, key)
+		if errors.Is(err, ErrNotFound) {
+			missing = append(missing, key)
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
+		}
+		issues = append(issues, found)
+	}
+
+	if len(missing) > 0 {
+		return nil, &retrieval.NotFoundError{ResourceType: "issue", Names: missing}
+	}
+
+	return issues, nil
+}
+
+// 캐시에 없거나 만료된 이슈는 저장소를 차례대로 조회해서 캐시합니다.
+func (s *Service) get(ctx context.Context, key string) (*issue.Issue, error) {
+	if cached, ok := s.cache.Get(key); ok {
+		if time.Now().Before(cached.expiresAt) {
+			return cached.issue, nil
+		}
+		s.cache.Remove(key)
+	}
+
+	for _, store := range s.Stores {
+		found, err := store.Get(ctx, key)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		s.cache.Add(key, cachedIssue{issue: found, expiresAt: time.Now().Add(s.cacheTTL)})
+		return found, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (s *Service) Search(
+	ctx context.Context,
+	query string,
+	filter *issue.IssueFilter,
+	page server.Page,
+) ([]*issue.Issue, string, int, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, "", 0, retrieval.InvalidArgument("query", "is required")
+	}
+	return s.search(ctx, query, filter, page)
+}
+
+func (s *Service) List(
+	ctx context.Context,
+	filter *issue.IssueFilter,
+	page server.Page,
+) ([]*issue.Issue, string, int, error) {
+	return s.search(ctx, "", filter, page)
+}
+
+func (s *Service) search(
+	ctx context.Context,
+	query string,
+	filter *issue.IssueFilter,
+	page server.Page,
+) ([]*issue.Issue, string, int, error) {
+	if page.Size < 0 {
+		return nil, "", 0, retrieval.InvalidArgument("page_size", "must not be negative")
+	}
+	limit := page.Size
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	limit = min(limit, maxPageSize)
+
+	hash, err := fingerprint(query, filter)
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("failed to hash request: %w", err)
+	}
+	offset, err := decodePageToken(page.Token, hash)
+	if err != nil {
+		return nil, "", 0, retrieval.InvalidArgument("page_token", err.Error())
+	}
+
+	f, err := toFilter(filter)
+	if err != nil {
+		return nil, "", 0, retrieval.InvalidArgument("filter", err.Error())
+	}
+
+	issues, total, err := s.Index.Search(ctx, SearchParams{
+		Text:   query,
+		Filter: f,
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	var next string
+	if offset+len(issues) < total {
+		next = encodePageToken(offset+len(issues), hash)
+	}
+
+	return issues, next, total, nil
+}
+
+func toFilter(filter *issue.IssueFilter) (Filter, error) {
+	if filter == nil {
+		return Filter{}, nil
+	}
+
+	created, err := toTimeRange(filter.Created)
+	if err != nil {
+		return Filter{}, fmt.Errorf("invalid created range: %w", err)
+	}
+	updated, err := toTimeRange(filter.Updated)
+	if err != nil {
+		return Filter{}, fmt.Errorf("invalid updated range: %w", err)
+	}
+
+	return Filter{
+		Projects:  filter.Projects,
+		Statuses:  filter.Statuses,
+		Labels:    filter.Labels,
+		Assignees: filter.Assignees,
+		Created:   created,
+		Updated:   updated,
+	}, nil
+}
+
+func toTimeRange(r *issue.TimeRange) (TimeRange, error) {
+	var tr TimeRange
+	if r == nil {
+		return tr, nil
+	}
+
+	if r.Start != nil {
+		if err := r.Start.CheckValid(); err != nil {
+			return tr, err
+		}
+		tr.Start = r.Start.AsTime()
+	}
+	if r.End != nil {
+		if err := r.End.CheckValid(); err != nil {
+			return tr, err
+		}
+		tr.End = r.End.AsTime()
+	}
+	return tr, nil
+}
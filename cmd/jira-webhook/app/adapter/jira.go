@@ -0,0 +1,79 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/handler"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+var _ handler.IssueFetcher = (*JiraClient)(nil)
+
+// 청크를 만드는 데 필요한 이슈 필드.
+const jiraFields = "summary,description,comment,status,labels,created,updated"
+
+// Jira REST API로 이슈 전체를 조회합니다. 코멘트 웹훅에는 이슈 일부만 있으므로 색인하기 전에 다시 읽을 때 사용합니다.
+type JiraClient struct {
+	client *http.Client
+
+	// Jira 기본 URL. e.g., "https://jira.example.com"
+	baseURL string
+	token   string
+}
+
+func NewJiraClient(baseURL, token string) *JiraClient {
+	return &JiraClient{
+		client:  &http.Client{Timeout: 10 * time.Second},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+func (c *JiraClient) GetIssue(ctx context.Context, key string) (*jira.Issue, error) {
+	u := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=%s", c.baseURL, url.PathEscape(key), jiraFields)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Warn("failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, handler.ErrIssueNotFound
+	default:
+		return nil, fmt.Errorf("failed to get issue %s: jira: %s", key, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue jira.Issue
+	if err := json.Unmarshal(data, &issue); err != nil {
+		return nil, fmt.Errorf("failed to parse issue %s: %w", key, err)
+	}
+	return &issue, nil
+}
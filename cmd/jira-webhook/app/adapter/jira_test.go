@@ -0,0 +1,85 @@
+package adapter_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/adapter"
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/handler"
+)
+
+func TestGetIssue(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		status    int
+		body      string
+		expectErr error
+		expectKey string
+	}{
+		{
+			desc:      "found",
+			status:    http.StatusOK,
+			body:      `{"id":"185730","key":"AA-1","fields":{"summary":"Title","description":"desc","comment":{"total":1,"comments":[{"id":"1","body":"hello"}]}}}`,
+			expectKey: "AA-1",
+		},
+		{
+			desc:      "not found",
+			status:    http.StatusNotFound,
+			expectErr: handler.ErrIssueNotFound,
+		},
+		{
+			desc:   "server error",
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			desc:   "invalid body",
+			status: http.StatusOK,
+			body:   `{`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var path, fields, auth string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path, fields, auth = r.URL.Path, r.URL.Query().Get("fields"), r.Header.Get("Authorization")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			issue, err := adapter.NewJiraClient(srv.URL+"/", "token").GetIssue(context.Background(), "AA-1")
+
+			if path != "/rest/api/2/issue/AA-1" {
+				t.Errorf("expected issue path, got %q", path)
+			}
+			if fields != "summary,description,comment,status,labels,created,updated" {
+				t.Errorf("expected issue fields, got %q", fields)
+			}
+			if auth != "Bearer token" {
+				t.Errorf("expected bearer token, got %q", auth)
+			}
+			if tc.expectKey == "" {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+					t.Errorf("expected %v, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get issue: %v", err)
+			}
+			if issue.Key != tc.expectKey {
+				t.Errorf("expected key %s, got %s", tc.expectKey, issue.Key)
+			}
+			if issue.Fields.Content != "desc" || len(issue.Fields.CommentInfo.Comments) != 1 {
+				t.Errorf("expected description and comments, got %+v", issue.Fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/qdrant/go-client/qdrant"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/service"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+var _ service.Indexer = (*QdrantClient)(nil)
+
+type qdrantOptions struct {
+	// 밀집 벡터 이름. 비어 있으면 컬렉션의 기본 벡터에 저장합니다.
+	denseVector string
+	// 희소 벡터 이름.
+	sparseVector string
+	// 청크 원문으로 희소 벡터를 만드는 Qdrant 추론 모델. 검색할 때와 같은 모델이어야 합니다.
+	sparseModel string
+}
+
+var defaultQdrantOptions = qdrantOptions{
+	sparseVector: "bm25",
+	sparseModel:  "qdrant/bm25",
+}
+
+type QdrantOption func(*qdrantOptions)
+
+func WithDenseVector(name string) QdrantOption {
+	return func(opt *qdrantOptions) {
+		opt.denseVector = name
+	}
+}
+
+func WithSparseVector(name, model string) QdrantOption {
+	return func(opt *qdrantOptions) {
+		opt.sparseVector = name
+		opt.sparseModel = model
+	}
+}
+
+type QdrantClient struct {
+	client     *qdrant.Client
+	collection string
+	options    *qdrantOptions
+	// 컬렉션에 희소 벡터가 있으면 참. 서버를 시작하기 전에 CheckSparseVector가 설정합니다.
+	sparse bool
+}
+
+func NewQdrantClient(config *qdrant.Config, collection string, opts ...QdrantOption) (*QdrantClient, error) {
+	options := defaultQdrantOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	client, err := qdrant.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QdrantClient{client: client, collection: collection, options: &options}, nil
+}
+
+// 컬렉션에 희소 벡터가 있는지 확인합니다. 있으면 이후의 청크를 희소 벡터와 함께 저장합니다.
+// 희소 벡터가 없는 컬렉션에 희소 벡터를 저장하면 Qdrant가 upsert를 거절하므로 확인하지 못하면 밀집 벡터만 저장합니다.
+func (q *QdrantClient) CheckSparseVector(ctx context.Context) error {
+	info, err := q.client.GetCollectionInfo(ctx, q.collection)
+	if err != nil {
+		return fmt.Errorf("failed to get collection info: %w", err)
+	}
+
+	_, ok := info.GetConfig().GetParams().GetSparseVectorsConfig().GetMap()[q.options.sparseVector]
+	q.sparse = ok
+	if !ok {
+		return fmt.Errorf("collection %q has no sparse vector %q", q.collection, q.options.sparseVector)
+	}
+	return nil
+}
+
+// 청크 포인트를 upsert한 뒤 남은 이전 청크를 지웁니다.
+// 포인트 ID가 이슈 번호와 청크 순서로 정해지므로 교체 중에도 검색 결과에서 이슈가 빠지지 않습니다.
+// 프로토타입 색인기가 같은 컬렉션에 저장한 포인트는 ID 규칙이 다르고 chunk 필드가 없으므로 함께 지웁니다.
+// 이전 포인트는 source와 관계없이 key로 찾습니다. Confluence 페이지의 key는 "confluence:<페이지 ID>"라서 이슈 번호와 겹치지 않습니다.
+func (q *QdrantClient) Replace(ctx context.Context, key string, params []service.IndexParams) error {
+	points := make([]*qdrant.PointStruct, 0, len(params))
+	for _, p := range params {
+		points = append(points, &qdrant.PointStruct{
+			Id:      pointID(key, p.Chunk.Index),
+			Payload: qdrant.NewValueMap(payload(p.Chunk)),
+			Vectors: q.vectors(p),
+		})
+	}
+
+	if len(points) > 0 {
+		_, err := q.client.Upsert(ctx, &qdrant.UpsertPoints{
+			CollectionName: q.collection,
+			Wait:           qdrant.PtrOf(true),
+			Points:         points,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upsert points: %w", err)
+		}
+	}
+
+	return q.delete(ctx, staleFilter(key, len(points)))
+}
+
+// 희소 벡터는 Qdrant가 청크 원문으로 만듭니다. 이름 붙은 벡터를 쓰지 않으면 기본 벡터만 저장합니다.
+func (q *QdrantClient) vectors(p service.IndexParams) *qdrant.Vectors {
+	if q.options.denseVector == "" && !q.sparse {
+		return qdrant.NewVectors(p.Vectors...)
+	}
+
+	vectors := map[string]*qdrant.Vector{
+		q.options.denseVector: qdrant.NewVectorDense(p.Vectors),
+	}
+	if q.sparse {
+		vectors[q.options.sparseVector] = qdrant.NewVectorDocument(&qdrant.Document{
+			Text:  p.Chunk.Text,
+			Model: q.options.sparseModel,
+		})
+	}
+	return qdrant.NewVectorsMap(vectors)
+}
+
+// 새 청크가 n개인 이슈에서 지울 포인트. chunk가 n 이상이거나 chunk 필드가 없는 포인트입니다.
+func staleFilter(key string, n int) *qdrant.Filter {
+	return &qdrant.Filter{
+		Must: []*qdrant.Condition{
+			qdrant.NewMatch("key", key),
+			qdrant.NewFilterAsCondition(&qdrant.Filter{
+				Should: []*qdrant.Condition{
+					qdrant.NewRange("chunk", &qdrant.Range{Gte: qdrant.PtrOf(float64(n))}),
+					qdrant.NewIsEmpty("chunk"),
+				},
+			}),
+		},
+	}
+}
+
+// 이슈의 모든 포인트를 지웁니다. 프로토타입 색인기가 저장한 같은 이슈의 포인트도 함께 지웁니다.
+func (q *QdrantClient) Delete(ctx context.Context, key string) error {
+	return q.delete(ctx, &qdrant.Filter{
+		Must: []*qdrant.Condition{
+			qdrant.NewMatch("key", key),
+		},
+	})
+}
+
+func (q *QdrantClient) delete(ctx context.Context, filter *qdrant.Filter) error {
+	_, err := q.client.Delete(ctx, &qdrant.DeletePoints{
+		CollectionName: q.collection,
+		Wait:           qdrant.PtrOf(true),
+		Points:         qdrant.NewPointsSelectorFilter(filter),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete points: %w", err)
+	}
+	return nil
+}
+
+// 검색 조건으로 사용할 수 있도록 제목, 프로젝트, 상태, 레이블, 날짜를 함께 저장합니다.
+// 날짜는 Qdrant가 범위 조건으로 비교할 수 있는 RFC 3339 형식으로 저장합니다.
+func payload(c service.Chunk) map[string]any {
+	labels := make([]any, 0, len(c.Labels))
+	for _, l := range c.Labels {
+		labels = append(labels, l)
+	}
+
+	p := map[string]any{
+		"key":    c.Key,
+		"title":  c.Title,
+		"value":  c.Text,
+		"chunk":  c.Index,
+		"status": c.Status,
+		"labels": labels,
+	}
+	if project, _, ok := strings.Cut(c.Key, "-"); ok {
+		p["project"] = project
+	}
+	for field, value := range map[string]string{"created": c.Created, "updated": c.Updated} {
+		if t, err := jira.ParseTime(value); err == nil {
+			p[field] = t.Format(time.RFC3339)
+		}
+	}
+	return p
+}
+
+// 이슈 번호와 청크 순서로 정해지는 포인트 ID.
+func pointID(key string, index int) *qdrant.PointId {
+	id := uuid.NewSHA1(uuid.NameSpaceURL, fmt.Appendf(nil, "jira:%s#%d", key, index))
+	return qdrant.NewIDUUID(id.String())
+}
@@ -0,0 +1,272 @@
+package adapter_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/adapter"
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/service"
+)
+
+// Upsert와 Delete 요청을 기록하는 Qdrant 포인트 서버.
+type fakePoints struct {
+	qdrant.UnimplementedPointsServer
+
+	mu      sync.Mutex
+	upserts []*qdrant.UpsertPoints
+	deletes []*qdrant.DeletePoints
+}
+
+func (f *fakePoints) Upsert(ctx context.Context, req *qdrant.UpsertPoints) (*qdrant.PointsOperationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.upserts = append(f.upserts, req)
+	return &qdrant.PointsOperationResponse{Result: &qdrant.UpdateResult{Status: qdrant.UpdateStatus_Completed}}, nil
+}
+
+func (f *fakePoints) Delete(ctx context.Context, req *qdrant.DeletePoints) (*qdrant.PointsOperationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.deletes = append(f.deletes, req)
+	return &qdrant.PointsOperationResponse{Result: &qdrant.UpdateResult{Status: qdrant.UpdateStatus_Completed}}, nil
+}
+
+// 컬렉션 정보를 반환하는 Qdrant 컬렉션 서버.
+type fakeCollections struct {
+	qdrant.UnimplementedCollectionsServer
+
+	sparseVectors map[string]*qdrant.SparseVectorParams
+}
+
+func (f *fakeCollections) Get(ctx context.Context, req *qdrant.GetCollectionInfoRequest) (*qdrant.GetCollectionInfoResponse, error) {
+	return &qdrant.GetCollectionInfoResponse{
+		Result: &qdrant.CollectionInfo{
+			Config: &qdrant.CollectionConfig{
+				Params: &qdrant.CollectionParams{
+					SparseVectorsConfig: qdrant.NewSparseVectorsConfig(f.sparseVectors),
+				},
+			},
+		},
+	}, nil
+}
+
+func newQdrantClient(t *testing.T) (*adapter.QdrantClient, *fakePoints) {
+	return newQdrantClientWithCollection(t, &fakeCollections{})
+}
+
+func newQdrantClientWithCollection(t *testing.T, collections *fakeCollections, opts ...adapter.QdrantOption) (*adapter.QdrantClient, *fakePoints) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	points := &fakePoints{}
+	s := grpc.NewServer()
+	qdrant.RegisterPointsServer(s, points)
+	qdrant.RegisterCollectionsServer(s, collections)
+	go func() { _ = s.Serve(listener) }()
+	t.Cleanup(s.Stop)
+
+	client, err := adapter.NewQdrantClient(&qdrant.Config{
+		Host:                   "127.0.0.1",
+		Port:                   listener.Addr().(*net.TCPAddr).Port,
+		SkipCompatibilityCheck: true,
+	}, "content", opts...)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client, points
+}
+
+func TestReplace(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		chunks int
+	}{
+		{
+			desc:   "replace chunks",
+			chunks: 2,
+		},
+		{
+			desc:   "no chunks",
+			chunks: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client, points := newQdrantClient(t)
+
+			var params []service.IndexParams
+			for i := range tc.chunks {
+				params = append(params, service.IndexParams{
+					Chunk:   service.Chunk{Key: "AA-1", Title: "title", Index: i, Text: "text", Status: "Open", Created: "2025-07-25T11:20:55.000+0900"},
+					Vectors: []float32{0.1, 0.2},
+				})
+			}
+			if err := client.Replace(context.Background(), "AA-1", params); err != nil {
+				t.Fatalf("failed to replace: %v", err)
+			}
+
+			expectedUpserts := 1
+			if tc.chunks == 0 {
+				expectedUpserts = 0
+			}
+			if len(points.upserts) != expectedUpserts {
+				t.Fatalf("expected %d upserts, got %d", expectedUpserts, len(points.upserts))
+			}
+			if expectedUpserts > 0 {
+				upserted := points.upserts[0].GetPoints()
+				if len(upserted) != tc.chunks {
+					t.Fatalf("expected %d points, got %d", tc.chunks, len(upserted))
+				}
+				for i, p := range upserted {
+					if got := p.GetPayload()["chunk"].GetIntegerValue(); got != int64(i) {
+						t.Errorf("expected chunk %d, got %d", i, got)
+					}
+					if got := p.GetPayload()["project"].GetStringValue(); got != "AA" {
+						t.Errorf("expected project AA, got %q", got)
+					}
+					if got := p.GetPayload()["created"].GetStringValue(); got != "2025-07-25T11:20:55+09:00" {
+						t.Errorf("expected RFC 3339 created, got %q", got)
+					}
+				}
+			}
+
+			// chunk가 새 청크 수 이상이거나 chunk 필드가 없는 이전 포인트를 지운다.
+			expected := &qdrant.Filter{
+				Must: []*qdrant.Condition{
+					qdrant.NewMatch("key", "AA-1"),
+					qdrant.NewFilterAsCondition(&qdrant.Filter{
+						Should: []*qdrant.Condition{
+							qdrant.NewRange("chunk", &qdrant.Range{Gte: qdrant.PtrOf(float64(tc.chunks))}),
+							qdrant.NewIsEmpty("chunk"),
+						},
+					}),
+				},
+			}
+			if len(points.deletes) != 1 {
+				t.Fatalf("expected 1 delete, got %d", len(points.deletes))
+			}
+			if got := points.deletes[0].GetPoints().GetFilter(); !proto.Equal(got, expected) {
+				t.Errorf("expected filter %v, got %v", expected, got)
+			}
+		})
+	}
+}
+
+// 같은 이슈 번호와 청크 순서는 항상 같은 포인트 ID로 저장해서 upsert가 이전 청크를 덮어쓴다.
+func TestReplacePointID(t *testing.T) {
+	client, points := newQdrantClient(t)
+
+	params := []service.IndexParams{{Chunk: service.Chunk{Key: "AA-1"}, Vectors: []float32{0.1}}}
+	for range 2 {
+		if err := client.Replace(context.Background(), "AA-1", params); err != nil {
+			t.Fatalf("failed to replace: %v", err)
+		}
+	}
+	if err := client.Replace(context.Background(), "AA-2", []service.IndexParams{{Chunk: service.Chunk{Key: "AA-2"}, Vectors: []float32{0.1}}}); err != nil {
+		t.Fatalf("failed to replace: %v", err)
+	}
+
+	first := points.upserts[0].GetPoints()[0].GetId()
+	second := points.upserts[1].GetPoints()[0].GetId()
+	other := points.upserts[2].GetPoints()[0].GetId()
+	if !proto.Equal(first, second) {
+		t.Errorf("expected same point id, got %v and %v", first, second)
+	}
+	if proto.Equal(first, other) {
+		t.Errorf("expected different point ids for different issues, got %v", other)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	client, points := newQdrantClient(t)
+
+	if err := client.Delete(context.Background(), "AA-1"); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+
+	expected := &qdrant.Filter{Must: []*qdrant.Condition{qdrant.NewMatch("key", "AA-1")}}
+	if len(points.deletes) != 1 {
+		t.Fatalf("expected 1 delete, got %d", len(points.deletes))
+	}
+	if got := points.deletes[0].GetPoints().GetFilter(); !proto.Equal(got, expected) {
+		t.Errorf("expected filter %v, got %v", expected, got)
+	}
+}
+
+func TestReplaceVectors(t *testing.T) {
+	bm25 := map[string]*qdrant.SparseVectorParams{"bm25": {Modifier: qdrant.Modifier_Idf.Enum()}}
+	dense := []float32{0.1, 0.2}
+	document := qdrant.NewVectorDocument(&qdrant.Document{Text: "text", Model: "qdrant/bm25"})
+
+	testCases := []struct {
+		desc          string
+		opts          []adapter.QdrantOption
+		sparseVectors map[string]*qdrant.SparseVectorParams
+		expectErr     bool
+		expected      *qdrant.Vectors
+	}{
+		{
+			desc:          "default dense and sparse vectors",
+			sparseVectors: bm25,
+			expected: qdrant.NewVectorsMap(map[string]*qdrant.Vector{
+				"":     qdrant.NewVectorDense(dense),
+				"bm25": document,
+			}),
+		},
+		{
+			desc:          "named dense and sparse vectors",
+			opts:          []adapter.QdrantOption{adapter.WithDenseVector("dense"), adapter.WithSparseVector("text", "custom/bm25")},
+			sparseVectors: map[string]*qdrant.SparseVectorParams{"text": {Modifier: qdrant.Modifier_Idf.Enum()}},
+			expected: qdrant.NewVectorsMap(map[string]*qdrant.Vector{
+				"dense": qdrant.NewVectorDense(dense),
+				"text":  qdrant.NewVectorDocument(&qdrant.Document{Text: "text", Model: "custom/bm25"}),
+			}),
+		},
+		{
+			desc:      "collection without sparse vector",
+			expectErr: true,
+			expected:  qdrant.NewVectors(dense...),
+		},
+		{
+			desc:      "named dense vector without sparse vector",
+			opts:      []adapter.QdrantOption{adapter.WithDenseVector("dense")},
+			expectErr: true,
+			expected: qdrant.NewVectorsMap(map[string]*qdrant.Vector{
+				"dense": qdrant.NewVectorDense(dense),
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client, points := newQdrantClientWithCollection(t, &fakeCollections{sparseVectors: tc.sparseVectors}, tc.opts...)
+
+			err := client.CheckSparseVector(context.Background())
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectErr, err)
+			}
+
+			params := []service.IndexParams{{Chunk: service.Chunk{Key: "AA-1", Text: "text"}, Vectors: dense}}
+			if err := client.Replace(context.Background(), "AA-1", params); err != nil {
+				t.Fatalf("failed to replace: %v", err)
+			}
+
+			got := points.upserts[0].GetPoints()[0].GetVectors()
+			if !proto.Equal(got, tc.expected) {
+				t.Errorf("expected vectors %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
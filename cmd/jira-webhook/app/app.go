@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/qdrant/go-client/qdrant"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/adapter"
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/handler"
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/service"
+)
+
+const (
+	defaultPort       = "8080"
+	defaultCollection = "content"
+	// 희소 벡터 이름과 청크 원문으로 희소 벡터를 만드는 Qdrant 추론 모델.
+	defaultSparseVector = "bm25"
+	defaultSparseModel  = "qdrant/bm25"
+	defaultChunkSize    = 1000
+	// 종료 신호를 받은 뒤 처리 중인 웹훅을 기다리는 최대 시간.
+	shutdownTimeout = 30 * time.Second
+	// 시작할 때 컬렉션의 희소 벡터를 확인하는 최대 시간.
+	startupCheckTimeout = 10 * time.Second
+)
+
+func Run() error {
+	secret, ok := os.LookupEnv("WEBHOOK_SECRET")
+	if !ok || secret == "" {
+		return errors.New("WEBHOOK_SECRET is not set")
+	}
+
+	qdrantHost, ok := os.LookupEnv("QDRANT_HOST")
+	if !ok {
+		return errors.New("QDRANT_HOST is not set")
+	}
+	collection := getenv("QDRANT_COLLECTION", defaultCollection)
+	indexer, err := adapter.NewQdrantClient(&qdrant.Config{Host: qdrantHost}, collection,
+		adapter.WithDenseVector(os.Getenv("QDRANT_DENSE_VECTOR")),
+		adapter.WithSparseVector(getenv("QDRANT_SPARSE_VECTOR", defaultSparseVector), getenv("QDRANT_SPARSE_MODEL", defaultSparseModel)),
+	)
+	if err != nil {
+		return err
+	}
+	checkCtx, cancelCheck := context.WithTimeout(context.Background(), startupCheckTimeout)
+	if err := indexer.CheckSparseVector(checkCtx); err != nil {
+		slog.Warn("indexing dense vectors only", slog.Any("error", err))
+	}
+	cancelCheck()
+
+	embeddingURL, ok := os.LookupEnv("EMBEDDING_API_URL")
+	if !ok {
+		return errors.New("EMBEDDING_API_URL is not set")
+	}
+	embedder := adapter.NewOpenAIClient(embeddingURL)
+
+	chunkSize := defaultChunkSize
+	if v, ok := os.LookupEnv("CHUNK_SIZE"); ok {
+		if chunkSize, err = strconv.Atoi(v); err != nil || chunkSize <= 0 {
+			return errors.New("CHUNK_SIZE must be a positive integer")
+		}
+	}
+
+	svc := service.NewService(indexer, embedder, chunkSize)
+
+	var handlerOpts []handler.Option
+	if jiraURL := os.Getenv("JIRA_URL"); jiraURL != "" {
+		handlerOpts = append(handlerOpts, handler.WithIssueFetcher(adapter.NewJiraClient(jiraURL, os.Getenv("JIRA_TOKEN"))))
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/webhook/jira", handler.NewHandler(svc, secret, handlerOpts...))
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	s := &http.Server{
+		Addr:              net.JoinHostPort("", getenv("WEBHOOK_PORT", defaultPort)),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	shutdown := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		shutdown <- s.Shutdown(shutdownCtx)
+	}()
+
+	if err := s.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	// 처리 중인 웹훅이 끝날 때까지 기다린다.
+	return <-shutdown
+}
+
+func getenv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+// Jira 웹훅 이벤트 종류.
+const (
+	EventIssueCreated   = "jira:issue_created"
+	EventIssueUpdated   = "jira:issue_updated"
+	EventIssueDeleted   = "jira:issue_deleted"
+	EventCommentCreated = "comment_created"
+	EventCommentUpdated = "comment_updated"
+	EventCommentDeleted = "comment_deleted"
+)
+
+// Jira 웹훅 요청 본문.
+// https://developer.atlassian.com/server/jira/platform/webhooks/
+type Event struct {
+	// 이벤트 발생 시각. (Unix milliseconds)
+	Timestamp int64 `json:"timestamp"`
+	// 이벤트 종류. e.g., "jira:issue_updated"
+	WebhookEvent string `json:"webhookEvent"`
+	// 이벤트 대상 이슈.
+	Issue *jira.Issue `json:"issue"`
+	// 코멘트 이벤트의 대상 코멘트.
+	Comment *jira.Comment `json:"comment"`
+}
+
+// 코멘트 이벤트의 이슈에는 코멘트 목록이 빠져 있을 수 있으므로 이벤트의 코멘트를 이슈에 반영합니다.
+func (e *Event) applyComment() {
+	if e.Comment == nil || e.Issue == nil {
+		return
+	}
+
+	info := &e.Issue.Fields.CommentInfo
+	i := slices.IndexFunc(info.Comments, func(c jira.Comment) bool {
+		return c.ID == e.Comment.ID
+	})
+
+	switch {
+	case e.WebhookEvent == EventCommentDeleted:
+		if i >= 0 {
+			info.Comments = slices.Delete(info.Comments, i, i+1)
+		}
+	case i >= 0:
+		info.Comments[i] = *e.Comment
+	default:
+		info.Comments = append(info.Comments, *e.Comment)
+	}
+	info.Total = len(info.Comments)
+}
+
+// 웹훅 본문의 이슈에 본문(description)과 코멘트(comment) 필드가 모두 있는지 확인합니다.
+// 값이 null이어도 필드가 있으면 비어 있는 것으로 봅니다.
+func hasIssueContent(body []byte) bool {
+	var payload struct {
+		Issue struct {
+			Fields map[string]json.RawMessage `json:"fields"`
+		} `json:"issue"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+
+	_, hasDescription := payload.Issue.Fields["description"]
+	_, hasComment := payload.Issue.Fields["comment"]
+	return hasDescription && hasComment
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+// 웹훅 요청 본문의 최대 크기.
+const maxBodySize = 10 << 20
+
+// 이슈를 조회할 수 없을 때 IssueFetcher가 반환하는 오류.
+var ErrIssueNotFound = errors.New("issue not found")
+
+type Service interface {
+	IndexIssue(ctx context.Context, issue *jira.Issue) error
+	DeleteIssue(ctx context.Context, key string) error
+}
+
+// 코멘트 이벤트에서 이슈 전체를 다시 읽어 오는 저장소.
+type IssueFetcher interface {
+	// 이슈가 없으면 ErrIssueNotFound를 반환합니다.
+	GetIssue(ctx context.Context, key string) (*jira.Issue, error)
+}
+
+type handlerOptions struct {
+	// 코멘트 이벤트의 이슈를 조회할 저장소. nil이면 웹훅 본문의 이슈를 사용합니다.
+	fetcher IssueFetcher
+}
+
+var defaultHandlerOptions = handlerOptions{}
+
+type Option func(*handlerOptions)
+
+func WithIssueFetcher(fetcher IssueFetcher) Option {
+	return func(opt *handlerOptions) {
+		opt.fetcher = fetcher
+	}
+}
+
+// Jira 웹훅을 받아 이슈 인덱스를 갱신합니다.
+type Handler struct {
+	service Service
+	secret  []byte
+
+	options *handlerOptions
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+func NewHandler(service Service, secret string, opts ...Option) *Handler {
+	options := defaultHandlerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &Handler{
+		service: service,
+		secret:  []byte(secret),
+		options: &options,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if !h.verify(r, body) {
+		slog.Warn("webhook secret mismatch", slog.String("remote", r.RemoteAddr))
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if event.Issue == nil || event.Issue.Key == "" {
+		http.Error(w, "issue is missing", http.StatusBadRequest)
+		return
+	}
+
+	logger := slog.With(slog.String("event", event.WebhookEvent), slog.String("key", event.Issue.Key))
+
+	switch event.WebhookEvent {
+	case EventIssueCreated, EventIssueUpdated:
+		err = h.service.IndexIssue(r.Context(), event.Issue)
+	case EventCommentCreated, EventCommentUpdated, EventCommentDeleted:
+		if h.options.fetcher == nil && !hasIssueContent(body) {
+			logger.Warn("skipping comment event without issue description and comments")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		err = h.indexComment(r.Context(), &event)
+	case EventIssueDeleted:
+		err = h.service.DeleteIssue(r.Context(), event.Issue.Key)
+	default:
+		logger.Info("ignoring webhook event")
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	// 실패하면 5xx로 응답해서 Jira가 웹훅을 다시 보내도록 한다.
+	if err != nil {
+		logger.Error("failed to handle webhook event", slog.Any("error", err))
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// 코멘트 웹훅의 이슈에는 본문과 다른 코멘트가 빠져 있어서 그대로 색인하면 기존 청크를 지우게 된다.
+// IssueFetcher가 있으면 Jira에서 이슈 전체를 다시 읽어 색인하고, 그 사이에 이슈가 삭제되었으면 청크를 지웁니다.
+// 없으면 본문과 코멘트 목록이 있는 웹훅 이슈에 이벤트의 코멘트를 반영해서 색인합니다.
+func (h *Handler) indexComment(ctx context.Context, event *Event) error {
+	if h.options.fetcher == nil {
+		event.applyComment()
+		return h.service.IndexIssue(ctx, event.Issue)
+	}
+
+	issue, err := h.options.fetcher.GetIssue(ctx, event.Issue.Key)
+	if errors.Is(err, ErrIssueNotFound) {
+		return h.service.DeleteIssue(ctx, event.Issue.Key)
+	} else if err != nil {
+		return err
+	}
+	return h.service.IndexIssue(ctx, issue)
+}
+
+// 공유 비밀 값을 확인합니다.
+// Jira Cloud처럼 X-Hub-Signature 헤더(HMAC-SHA256 서명)가 있으면 서명을 확인하고,
+// 서명을 지원하지 않는 Jira Server는 X-Webhook-Secret 헤더의 값을 비교합니다.
+// URL 쿼리 파라미터는 접근 로그에 남으므로 비밀 값으로 받지 않습니다.
+func (h *Handler) verify(r *http.Request, body []byte) bool {
+	if signature := r.Header.Get("X-Hub-Signature"); signature != "" {
+		algorithm, value, ok := strings.Cut(signature, "=")
+		if !ok || algorithm != "sha256" {
+			return false
+		}
+		expected, err := hex.DecodeString(value)
+		if err != nil {
+			return false
+		}
+
+		mac := hmac.New(sha256.New, h.secret)
+		mac.Write(body)
+		return hmac.Equal(mac.Sum(nil), expected)
+	}
+
+	secret := r.Header.Get("X-Webhook-Secret")
+	return subtle.ConstantTimeCompare([]byte(secret), h.secret) == 1
+}
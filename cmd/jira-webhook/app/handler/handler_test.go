@@ -0,0 +1,393 @@
+package handler_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/handler"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+const secret = "webhook-secret"
+
+// 호출을 기록하는 Service.
+type fakeService struct {
+	indexed []*jira.Issue
+	deleted []string
+	err     error
+}
+
+func (s *fakeService) IndexIssue(ctx context.Context, issue *jira.Issue) error {
+	s.indexed = append(s.indexed, issue)
+	return s.err
+}
+
+func (s *fakeService) DeleteIssue(ctx context.Context, key string) error {
+	s.deleted = append(s.deleted, key)
+	return s.err
+}
+
+// 미리 정한 이슈를 반환하는 IssueFetcher.
+type fakeFetcher struct {
+	issue *jira.Issue
+	err   error
+	keys  []string
+}
+
+func (f *fakeFetcher) GetIssue(ctx context.Context, key string) (*jira.Issue, error) {
+	f.keys = append(f.keys, key)
+	return f.issue, f.err
+}
+
+func sign(body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func post(t *testing.T, h http.Handler, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook/jira", strings.NewReader(body))
+	req.Header.Set("X-Hub-Signature", sign(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	return string(data)
+}
+
+func TestVerify(t *testing.T) {
+	// 처리하지 않는 이벤트라서 인증에 성공하면 202를 반환한다.
+	const body = `{"webhookEvent":"jira:worklog_updated","issue":{"key":"AA-1"}}`
+
+	testCases := []struct {
+		desc     string
+		header   map[string]string
+		query    string
+		expected int
+	}{
+		{
+			desc:     "valid signature",
+			header:   map[string]string{"X-Hub-Signature": sign(body)},
+			expected: http.StatusAccepted,
+		},
+		{
+			desc:     "signature of another body",
+			header:   map[string]string{"X-Hub-Signature": sign(body + " ")},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "unsupported algorithm",
+			header:   map[string]string{"X-Hub-Signature": "sha1=" + strings.TrimPrefix(sign(body), "sha256=")},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "signature without algorithm",
+			header:   map[string]string{"X-Hub-Signature": strings.TrimPrefix(sign(body), "sha256=")},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "signature not hex",
+			header:   map[string]string{"X-Hub-Signature": "sha256=zz"},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "invalid signature ignores valid secret header",
+			header:   map[string]string{"X-Hub-Signature": "sha256=00", "X-Webhook-Secret": secret},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "secret header",
+			header:   map[string]string{"X-Webhook-Secret": secret},
+			expected: http.StatusAccepted,
+		},
+		{
+			desc:     "secret query not accepted",
+			query:    "?secret=" + secret,
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "wrong secret",
+			header:   map[string]string{"X-Webhook-Secret": "wrong-secret"},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "secret prefix",
+			header:   map[string]string{"X-Webhook-Secret": secret[:len(secret)-1]},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc:     "missing secret",
+			expected: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svc := &fakeService{}
+			h := handler.NewHandler(svc, secret)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook/jira"+tc.query, strings.NewReader(body))
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tc.expected {
+				t.Errorf("expected status %d, got %d", tc.expected, rec.Code)
+			}
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		method   string
+		body     string
+		err      error
+		expected int
+		indexed  []string
+		deleted  []string
+	}{
+		{
+			desc:     "method not allowed",
+			method:   http.MethodGet,
+			expected: http.StatusMethodNotAllowed,
+		},
+		{
+			desc:     "invalid payload",
+			body:     `{`,
+			expected: http.StatusBadRequest,
+		},
+		{
+			desc:     "missing issue",
+			body:     `{"webhookEvent":"jira:issue_created"}`,
+			expected: http.StatusBadRequest,
+		},
+		{
+			desc:     "issue created",
+			body:     `{"webhookEvent":"jira:issue_created","issue":{"key":"AA-1","fields":{"summary":"title"}}}`,
+			expected: http.StatusNoContent,
+			indexed:  []string{"AA-1"},
+		},
+		{
+			desc:     "issue updated",
+			body:     `{"webhookEvent":"jira:issue_updated","issue":{"key":"AA-1","fields":{"summary":"title"}}}`,
+			expected: http.StatusNoContent,
+			indexed:  []string{"AA-1"},
+		},
+		{
+			desc:     "issue deleted",
+			body:     `{"webhookEvent":"jira:issue_deleted","issue":{"key":"AA-1"}}`,
+			expected: http.StatusNoContent,
+			deleted:  []string{"AA-1"},
+		},
+		{
+			desc:     "ignored event",
+			body:     `{"webhookEvent":"worklog_created","issue":{"key":"AA-1"}}`,
+			expected: http.StatusAccepted,
+		},
+		{
+			desc:     "service error",
+			body:     `{"webhookEvent":"jira:issue_updated","issue":{"key":"AA-1"}}`,
+			err:      errors.New("qdrant unavailable"),
+			expected: http.StatusInternalServerError,
+			indexed:  []string{"AA-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svc := &fakeService{err: tc.err}
+			h := handler.NewHandler(svc, secret)
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/webhook/jira", strings.NewReader(tc.body))
+			req.Header.Set("X-Hub-Signature", sign(tc.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tc.expected {
+				t.Errorf("expected status %d, got %d", tc.expected, rec.Code)
+			}
+			if got := issueKeys(svc.indexed); !slices.Equal(got, tc.indexed) {
+				t.Errorf("expected indexed %v, got %v", tc.indexed, got)
+			}
+			if !slices.Equal(svc.deleted, tc.deleted) {
+				t.Errorf("expected deleted %v, got %v", tc.deleted, svc.deleted)
+			}
+		})
+	}
+}
+
+func TestCommentEvent(t *testing.T) {
+	body := readFixture(t, "comment_created.json")
+	full := &jira.Issue{Key: "AA-12345", Fields: jira.IssueFields{Title: "Issue Summary", Content: "Issue Description"}}
+
+	testCases := []struct {
+		desc     string
+		fetcher  *fakeFetcher
+		expected int
+		indexed  []*jira.Issue
+		deleted  []string
+	}{
+		{
+			desc:     "skip partial issue without fetcher",
+			expected: http.StatusAccepted,
+		},
+		{
+			desc:     "index fetched issue",
+			fetcher:  &fakeFetcher{issue: full},
+			expected: http.StatusNoContent,
+			indexed:  []*jira.Issue{full},
+		},
+		{
+			desc:     "delete issue not found",
+			fetcher:  &fakeFetcher{err: handler.ErrIssueNotFound},
+			expected: http.StatusNoContent,
+			deleted:  []string{"AA-12345"},
+		},
+		{
+			desc:     "fetch error",
+			fetcher:  &fakeFetcher{err: errors.New("jira: 503 Service Unavailable")},
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svc := &fakeService{}
+			var opts []handler.Option
+			if tc.fetcher != nil {
+				opts = append(opts, handler.WithIssueFetcher(tc.fetcher))
+			}
+			h := handler.NewHandler(svc, secret, opts...)
+
+			rec := post(t, h, body)
+
+			if rec.Code != tc.expected {
+				t.Errorf("expected status %d, got %d", tc.expected, rec.Code)
+			}
+			if tc.fetcher != nil && !slices.Equal(tc.fetcher.keys, []string{"AA-12345"}) {
+				t.Errorf("expected fetch of [AA-12345], got %v", tc.fetcher.keys)
+			}
+			if !slices.Equal(svc.indexed, tc.indexed) {
+				t.Errorf("expected indexed %v, got %v", tc.indexed, svc.indexed)
+			}
+			if !slices.Equal(svc.deleted, tc.deleted) {
+				t.Errorf("expected deleted %v, got %v", tc.deleted, svc.deleted)
+			}
+		})
+	}
+}
+
+// IssueFetcher가 없으면 본문과 코멘트 목록이 있는 웹훅 이슈에 이벤트의 코멘트를 반영한다.
+func TestApplyComment(t *testing.T) {
+	existing := []jira.Comment{
+		{ID: "1", Body: "first"},
+		{ID: "2", Body: "second"},
+	}
+
+	testCases := []struct {
+		desc     string
+		event    string
+		comment  jira.Comment
+		expected []string
+	}{
+		{
+			desc:     "create",
+			event:    handler.EventCommentCreated,
+			comment:  jira.Comment{ID: "3", Body: "third"},
+			expected: []string{"first", "second", "third"},
+		},
+		{
+			desc:     "update",
+			event:    handler.EventCommentUpdated,
+			comment:  jira.Comment{ID: "2", Body: "edited"},
+			expected: []string{"first", "edited"},
+		},
+		{
+			desc:     "update missing comment",
+			event:    handler.EventCommentUpdated,
+			comment:  jira.Comment{ID: "3", Body: "third"},
+			expected: []string{"first", "second", "third"},
+		},
+		{
+			desc:     "delete",
+			event:    handler.EventCommentDeleted,
+			comment:  jira.Comment{ID: "1", Body: "first"},
+			expected: []string{"second"},
+		},
+		{
+			desc:     "delete missing comment",
+			event:    handler.EventCommentDeleted,
+			comment:  jira.Comment{ID: "3", Body: "third"},
+			expected: []string{"first", "second"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			issue := jira.Issue{Key: "AA-1", Fields: jira.IssueFields{Content: "description"}}
+			issue.Fields.CommentInfo.Comments = slices.Clone(existing)
+			issue.Fields.CommentInfo.Total = len(existing)
+			data, err := json.Marshal(handler.Event{WebhookEvent: tc.event, Issue: &issue, Comment: &tc.comment})
+			if err != nil {
+				t.Fatalf("failed to marshal event: %v", err)
+			}
+
+			svc := &fakeService{}
+			rec := post(t, handler.NewHandler(svc, secret), string(data))
+
+			if rec.Code != http.StatusNoContent {
+				t.Fatalf("expected status %d, got %d", http.StatusNoContent, rec.Code)
+			}
+			if len(svc.indexed) != 1 {
+				t.Fatalf("expected 1 indexed issue, got %d", len(svc.indexed))
+			}
+			info := svc.indexed[0].Fields.CommentInfo
+			var bodies []string
+			for _, c := range info.Comments {
+				bodies = append(bodies, c.Body)
+			}
+			if !slices.Equal(bodies, tc.expected) {
+				t.Errorf("expected comments %v, got %v", tc.expected, bodies)
+			}
+			if info.Total != len(tc.expected) {
+				t.Errorf("expected total %d, got %d", len(tc.expected), info.Total)
+			}
+		})
+	}
+}
+
+func issueKeys(issues []*jira.Issue) []string {
+	var keys []string
+	for _, issue := range issues {
+		keys = append(keys, issue.Key)
+	}
+	return keys
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/devafterdark/project-lumos/pkg/jira"
+	"github.com/devafterdark/project-lumos/pkg/jira/markup"
+)
+
+// 이슈 본문과 코멘트를 size 글자 이하의 청크로 나눕니다.
+// 문단 경계에서 나누고, 문단 하나가 size보다 길면 줄, 글자 순서로 나눕니다.
+// 검색 결과만 보고도 어떤 이슈인지 알 수 있도록 모든 청크 앞에 이슈 번호와 제목을 붙입니다.
+func SplitIssue(issue *jira.Issue, size int) []Chunk {
+	header := fmt.Sprintf("[%s] %s", issue.Key, issue.Fields.Title)
+
+	var paragraphs []string
+	paragraphs = append(paragraphs, splitParagraphs(markup.ToText(issue.Fields.Content))...)
+	for _, c := range issue.Fields.CommentInfo.Comments {
+		body := markup.ToText(c.Body)
+		if body == "" {
+			continue
+		}
+		paragraphs = append(paragraphs, splitParagraphs(fmt.Sprintf("%s: %s", c.Author.Name, body))...)
+	}
+
+	var (
+		chunks  []Chunk
+		current []string
+		length  int
+	)
+	flush := func() {
+		chunks = append(chunks, Chunk{
+			Key:     issue.Key,
+			Title:   issue.Fields.Title,
+			Index:   len(chunks),
+			Text:    header + "\n\n" + strings.Join(current, "\n\n"),
+			Status:  issue.Fields.Status.Name,
+			Labels:  issue.Fields.Labels,
+			Created: issue.Fields.Created,
+			Updated: issue.Fields.Updated,
+		})
+		current, length = nil, 0
+	}
+
+	for _, p := range paragraphs {
+		for _, piece := range splitLong(p, size) {
+			n := utf8.RuneCountInString(piece)
+			if length > 0 && length+n > size {
+				flush()
+			}
+			current = append(current, piece)
+			length += n
+		}
+	}
+	if length > 0 || len(chunks) == 0 {
+		flush()
+	}
+
+	return chunks
+}
+
+func splitParagraphs(s string) []string {
+	var paragraphs []string
+	for p := range strings.SplitSeq(s, "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	return paragraphs
+}
+
+// size보다 긴 문단을 줄 단위로, 줄도 길면 글자 단위로 나눕니다.
+func splitLong(p string, size int) []string {
+	if utf8.RuneCountInString(p) <= size {
+		return []string{p}
+	}
+
+	var (
+		pieces  []string
+		current []string
+		length  int
+	)
+	flush := func() {
+		if len(current) > 0 {
+			pieces = append(pieces, strings.Join(current, "\n"))
+			current, length = nil, 0
+		}
+	}
+
+	for line := range strings.SplitSeq(p, "\n") {
+		n := utf8.RuneCountInString(line)
+		if length+n+len(current) > size {
+			flush()
+		}
+		if n <= size {
+			current = append(current, line)
+			length += n
+			continue
+		}
+
+		runes := []rune(line)
+		for len(runes) > size {
+			pieces = append(pieces, string(runes[:size]))
+			runes = runes[size:]
+		}
+		current, length = []string{string(runes)}, len(runes)
+	}
+	flush()
+
+	return pieces
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/service"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+func TestSplitIssue(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		content  string
+		comments []jira.Comment
+		size     int
+		expected []string
+	}{
+		{
+			desc:     "empty issue",
+			size:     10,
+			expected: []string{""},
+		},
+		{
+			desc:     "paragraphs in one chunk",
+			content:  "abc\n\ndef",
+			size:     10,
+			expected: []string{"abc\n\ndef"},
+		},
+		{
+			desc:     "paragraph exactly fills chunk",
+			content:  "aaaaa\n\nbbbbb",
+			size:     10,
+			expected: []string{"aaaaa\n\nbbbbb"},
+		},
+		{
+			desc:     "split at paragraph boundary",
+			content:  "aaaaaa\n\nbbbbbb",
+			size:     10,
+			expected: []string{"aaaaaa", "bbbbbb"},
+		},
+		{
+			desc:     "skip blank paragraphs",
+			content:  "\n\n  abc  \n\n\n\n",
+			size:     10,
+			expected: []string{"abc"},
+		},
+		{
+			desc:     "split long paragraph at line boundary",
+			content:  "aaaa\nbbbb\ncccc",
+			size:     10,
+			expected: []string{"aaaa\nbbbb", "cccc"},
+		},
+		{
+			desc:     "split long line by characters",
+			content:  "abcdefghijklmnopqrstuvwxy",
+			size:     10,
+			expected: []string{"abcdefghij", "klmnopqrst", "uvwxy"},
+		},
+		{
+			desc:     "count characters not bytes",
+			content:  "가나다라마바사아자차카타",
+			size:     5,
+			expected: []string{"가나다라마", "바사아자차", "카타"},
+		},
+		{
+			desc:    "comments after description",
+			content: "desc",
+			comments: []jira.Comment{
+				{Author: jira.User{Name: "user"}, Body: "hello"},
+				{Author: jira.User{Name: "user"}, Body: ""},
+			},
+			size:     20,
+			expected: []string{"desc\n\nuser: hello"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			issue := &jira.Issue{
+				Key: "AA-1",
+				Fields: jira.IssueFields{
+					Title:   "Title",
+					Content: tc.content,
+					Status:  jira.Status{Name: "Open"},
+					Labels:  []string{"label"},
+					Created: "2025-07-25T11:20:55.000+0900",
+					Updated: "2025-07-25T13:59:32.000+0900",
+				},
+			}
+			issue.Fields.CommentInfo.Comments = tc.comments
+
+			chunks := service.SplitIssue(issue, tc.size)
+
+			var texts []string
+			for i, c := range chunks {
+				if c.Index != i {
+					t.Errorf("expected index %d, got %d", i, c.Index)
+				}
+				if c.Key != "AA-1" || c.Title != "Title" || c.Status != "Open" || !slices.Equal(c.Labels, []string{"label"}) {
+					t.Errorf("expected issue fields on chunk %d, got %+v", i, c)
+				}
+				if c.Created != issue.Fields.Created || c.Updated != issue.Fields.Updated {
+					t.Errorf("expected issue dates on chunk %d, got %q, %q", i, c.Created, c.Updated)
+				}
+				texts = append(texts, c.Text)
+			}
+
+			var expected []string
+			for _, text := range tc.expected {
+				expected = append(expected, "[AA-1] Title\n\n"+text)
+			}
+			if !slices.Equal(texts, expected) {
+				t.Errorf("expected %q, got %q", expected, texts)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import "context"
+
+// 이슈 본문을 나눈 검색 단위.
+type Chunk struct {
+	// 이슈 번호. e.g., "AA-12345"
+	Key string
+	// 이슈 제목.
+	Title string
+	// 이슈 안에서의 순서. 0부터 시작합니다.
+	Index int
+	// 임베딩할 텍스트.
+	Text string
+	// 이슈 상태. e.g., "Resolved"
+	Status string
+	// 이슈 레이블 목록.
+	Labels []string
+	// 이슈 생성일. (2006-01-02T15:04:05Z0700)
+	Created string
+	// 이슈 수정일. (2006-01-02T15:04:05Z0700)
+	Updated string
+}
+
+type IndexParams struct {
+	Chunk   Chunk
+	Vectors []float32
+}
+
+type Indexer interface {
+	// 이슈의 기존 청크를 모두 지우고 새 청크로 교체합니다.
+	Replace(ctx context.Context, key string, params []IndexParams) error
+	// 이슈의 청크를 모두 지웁니다.
+	Delete(ctx context.Context, key string) error
+}
+
+type Embedder interface {
+	Embed(ctx context.Context, text string) ([]float32, error)
+}
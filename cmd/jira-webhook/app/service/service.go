@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+type Service struct {
+	Indexer  Indexer
+	Embedder Embedder
+	// 청크 하나의 최대 글자 수.
+	ChunkSize int
+
+	// 같은 이슈의 이벤트가 동시에 처리되어 오래된 내용이 덮어쓰지 않도록 이슈마다 잠근다.
+	mu    sync.Mutex
+	locks map[string]*issueLock
+}
+
+type issueLock struct {
+	sync.Mutex
+	refs int
+}
+
+func NewService(i Indexer, e Embedder, chunkSize int) *Service {
+	return &Service{
+		Indexer:   i,
+		Embedder:  e,
+		ChunkSize: chunkSize,
+		locks:     make(map[string]*issueLock),
+	}
+}
+
+// 이슈를 청크로 나누고 임베딩해서 인덱스의 기존 청크를 교체합니다.
+func (s *Service) IndexIssue(ctx context.Context, issue *jira.Issue) error {
+	unlock := s.lock(issue.Key)
+	defer unlock()
+
+	chunks := SplitIssue(issue, s.ChunkSize)
+	params := make([]IndexParams, 0, len(chunks))
+	for _, chunk := range chunks {
+		vectors, err := s.Embedder.Embed(ctx, chunk.Text)
+		if err != nil {
+			return fmt.Errorf("failed to embed chunk %d: %w", chunk.Index, err)
+		}
+		params = append(params, IndexParams{Chunk: chunk, Vectors: vectors})
+	}
+
+	if err := s.Indexer.Replace(ctx, issue.Key, params); err != nil {
+		return fmt.Errorf("failed to replace chunks: %w", err)
+	}
+
+	slog.Info("issue indexed", slog.String("key", issue.Key), slog.Int("chunks", len(params)))
+	return nil
+}
+
+// 이슈의 청크를 인덱스에서 지웁니다.
+func (s *Service) DeleteIssue(ctx context.Context, key string) error {
+	unlock := s.lock(key)
+	defer unlock()
+
+	if err := s.Indexer.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete chunks: %w", err)
+	}
+
+	slog.Info("issue deleted", slog.String("key", key))
+	return nil
+}
+
+func (s *Service) lock(key string) func() {
+	s.mu.Lock()
+	l, ok := s.locks[key]
+	if !ok {
+		l = &issueLock{}
+		s.locks[key] = l
+	}
+	l.refs++
+	s.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		s.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(s.locks, key)
+		}
+		s.mu.Unlock()
+	}
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app/service"
+	"github.com/devafterdark/project-lumos/pkg/jira"
+)
+
+// 호출을 기록하는 Indexer.
+type fakeIndexer struct {
+	replaced map[string][]service.IndexParams
+	deleted  []string
+	err      error
+}
+
+func (f *fakeIndexer) Replace(ctx context.Context, key string, params []service.IndexParams) error {
+	if f.replaced == nil {
+		f.replaced = make(map[string][]service.IndexParams)
+	}
+	f.replaced[key] = params
+	return f.err
+}
+
+func (f *fakeIndexer) Delete(ctx context.Context, key string) error {
+	f.deleted = append(f.deleted, key)
+	return f.err
+}
+
+// 텍스트 길이를 벡터로 반환하는 Embedder.
+type fakeEmbedder struct {
+	texts []string
+	err   error
+}
+
+func (f *fakeEmbedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	f.texts = append(f.texts, text)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []float32{float32(len(text))}, nil
+}
+
+func TestIndexIssue(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		embedErr    error
+		replaceErr  error
+		expectErr   bool
+		expectCount int
+	}{
+		{
+			desc:        "index chunks",
+			expectCount: 2,
+		},
+		{
+			desc:      "embed error",
+			embedErr:  errors.New("embedder unavailable"),
+			expectErr: true,
+		},
+		{
+			desc:        "replace error",
+			replaceErr:  errors.New("qdrant unavailable"),
+			expectErr:   true,
+			expectCount: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			indexer := &fakeIndexer{err: tc.replaceErr}
+			embedder := &fakeEmbedder{err: tc.embedErr}
+			svc := service.NewService(indexer, embedder, 10)
+
+			issue := &jira.Issue{Key: "AA-1", Fields: jira.IssueFields{Title: "Title", Content: "aaaaaa\n\nbbbbbb"}}
+			err := svc.IndexIssue(context.Background(), issue)
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectErr, err)
+			}
+
+			params := indexer.replaced["AA-1"]
+			if len(params) != tc.expectCount {
+				t.Fatalf("expected %d chunks, got %d", tc.expectCount, len(params))
+			}
+			for i, p := range params {
+				if p.Chunk.Index != i {
+					t.Errorf("expected chunk %d, got %d", i, p.Chunk.Index)
+				}
+				if p.Vectors[0] != float32(len(p.Chunk.Text)) {
+					t.Errorf("expected vectors of chunk %d text, got %v", i, p.Vectors)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteIssue(t *testing.T) {
+	indexer := &fakeIndexer{}
+	svc := service.NewService(indexer, &fakeEmbedder{}, 10)
+
+	if err := svc.DeleteIssue(context.Background(), "AA-1"); err != nil {
+		t.Fatalf("failed to delete issue: %v", err)
+	}
+	if !slices.Equal(indexer.deleted, []string{"AA-1"}) {
+		t.Errorf("expected deleted [AA-1], got %v", indexer.deleted)
+	}
+
+	indexer.err = errors.New("qdrant unavailable")
+	if err := svc.DeleteIssue(context.Background(), "AA-1"); !errors.Is(err, indexer.err) {
+		t.Errorf("expected %v, got %v", indexer.err, err)
+	}
+}
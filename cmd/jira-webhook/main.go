@@ -0,0 +1,18 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/devafterdark/project-lumos/cmd/jira-webhook/app"
+)
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	slog.Info("Jira Webhook Receiver starting")
+	if err := app.Run(); err != nil {
+		slog.Error("failed to run jira webhook receiver", slog.Any("error", err))
+	}
+	slog.Info("Jira Webhook Receiver finished")
+}
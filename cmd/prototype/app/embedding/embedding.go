@@ -1,13 +1,18 @@
 package embedding
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
@@ -15,6 +20,7 @@ import (
 
 	"github.com/devafterdark/project-lumos/cmd/prototype/app"
 	"github.com/devafterdark/project-lumos/pkg/jira"
+	"github.com/devafterdark/project-lumos/pkg/jira/markup"
 )
 
 var (
@@ -37,18 +43,12 @@ var embeddingCmd = &cobra.Command{
 			return
 		}
 
-		data, err := os.ReadFile(input)
+		issues, err := readIssues(input)
 		if err != nil {
 			fmt.Println("error reading input file:", err)
 			return
 		}
 
-		issues := []jira.Issue{}
-		if err := json.Unmarshal(data, &issues); err != nil {
-			fmt.Println("error unmarshalling JSON:", err)
-			return
-		}
-
 		titleCh, contentCh := embedding(ctx, issues)
 
 		wg := sync.WaitGroup{}
@@ -93,9 +93,19 @@ var embeddingCmd = &cobra.Command{
 	},
 }
 
+// 수집기가 기록한 문서. Jira 이슈와 같은 형식이며, 수집 원본이 source에 기록됩니다.
+type document struct {
+	jira.Issue
+	// 수집 원본. e.g., "jira", "confluence"
+	Source string `json:"source"`
+}
+
+// 수집 원본이 기록되지 않은 문서는 Jira 이슈입니다.
+const defaultSource = "jira"
+
 func init() {
 	embeddingCmd.Flags().StringVarP(&address, "address", "a", "http://localhost:8080/v1", "API server address")
-	embeddingCmd.Flags().StringVarP(&input, "input", "i", "", "Input file path")
+	embeddingCmd.Flags().StringVarP(&input, "input", "i", "", "Input file path (JSON array or JSON Lines)")
 	embeddingCmd.Flags().StringVarP(&output, "output", "o", "embedding.json", "Output file path")
 
 	_ = embeddingCmd.MarkFlagRequired("input")
@@ -103,6 +113,40 @@ func init() {
 	app.AddCommand(embeddingCmd)
 }
 
+// readIssues는 JSON 배열 또는 JSON Lines 형식의 이슈 파일을 읽습니다.
+func readIssues(file string) ([]document, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := []document{}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		if err := json.Unmarshal(data, &issues); err != nil {
+			return nil, err
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		for {
+			issue := document{}
+			if err := decoder.Decode(&issue); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			issues = append(issues, issue)
+		}
+	}
+
+	// 두 형식 모두 수집 원본이 없으면 Jira 이슈로 본다.
+	for i := range issues {
+		if issues[i].Source == "" {
+			issues[i].Source = defaultSource
+		}
+	}
+	return issues, nil
+}
+
 func convert(in []float64) []float32 {
 	out := make([]float32, len(in))
 	for i, v := range in {
@@ -113,7 +157,7 @@ func convert(in []float64) []float32 {
 
 func embedding(
 	ctx context.Context,
-	issues []jira.Issue,
+	issues []document,
 ) (titleCh chan *app.Embedding, contentCh chan *app.Embedding) {
 	client := openai.NewClient(
 		option.WithBaseURL(address),
@@ -122,7 +166,7 @@ func embedding(
 	titleCh = make(chan *app.Embedding, 1)
 	contentCh = make(chan *app.Embedding, 1)
 
-	perform := func(issueKey, text string) *app.Embedding {
+	perform := func(issue *document, text string) *app.Embedding {
 		resp, err := client.Embeddings.New(ctx, openai.EmbeddingNewParams{
 			Input: openai.EmbeddingNewParamsInputUnion{
 				OfString: openai.String(text),
@@ -130,19 +174,16 @@ func embedding(
 			EncodingFormat: openai.EmbeddingNewParamsEncodingFormatFloat,
 		})
 		if err != nil {
-			fmt.Println("skipping issue", issueKey)
+			fmt.Println("skipping issue", issue.Key)
 			fmt.Println("error creating embedding:", err)
 			return nil
 		}
 		if len(resp.Data) < 1 {
-			fmt.Println("no embeddings returned for issue", issueKey)
+			fmt.Println("no embeddings returned for issue", issue.Key)
 			return nil
 		}
 		return &app.Embedding{
-			Payload: map[string]any{
-				"key":   issueKey,
-				"value": text,
-			},
+			Payload: payload(issue, text),
 			Vectors: convert(resp.Data[0].Embedding),
 		}
 	}
@@ -153,23 +194,57 @@ func embedding(
 			close(contentCh)
 		}()
 
-		for _, issue := range issues {
+		for i := range issues {
+			issue := &issues[i]
 			if ctx.Err() != nil {
 				fmt.Println("context cancelled, stopping processing")
 				return
 			}
 
 			// 이슈 제목에 대한 벡터 생성.
-			titleCh <- perform(issue.Key, issue.Fields.Title)
+			titleCh <- perform(issue, issue.Fields.Title)
 
-			// 이슈 본문에 대한 벡터 생성.
-			contentCh <- perform(issue.Key, issue.Fields.Content)
+			// 이슈 본문에 대한 벡터 생성. 위키 마크업은 검색 품질을 떨어뜨리므로 일반 텍스트로 변환한다.
+			// Confluence 페이지 본문은 수집할 때 이미 일반 텍스트로 변환했다.
+			content := issue.Fields.Content
+			if issue.Source == defaultSource {
+				content = markup.ToText(content)
+			}
+			contentCh <- perform(issue, content)
 		}
 	}()
 
 	return titleCh, contentCh
 }
 
+// 검색 조건으로 사용할 수 있도록 웹훅 수신기와 같은 필드(제목, 프로젝트, 상태, 레이블, 날짜)를 함께 저장합니다.
+// 날짜는 Qdrant가 범위 조건으로 비교할 수 있는 RFC 3339 형식으로 저장합니다.
+func payload(issue *document, text string) map[string]any {
+	labels := make([]any, 0, len(issue.Fields.Labels))
+	for _, l := range issue.Fields.Labels {
+		labels = append(labels, l)
+	}
+
+	p := map[string]any{
+		"key":    issue.Key,
+		"source": issue.Source,
+		"title":  issue.Fields.Title,
+		"value":  text,
+		"status": issue.Fields.Status.Name,
+		"labels": labels,
+	}
+	// Confluence 페이지 키(confluence:<페이지 ID>)에는 프로젝트가 없다.
+	if project, _, ok := strings.Cut(issue.Key, "-"); ok && issue.Source == defaultSource {
+		p["project"] = project
+	}
+	for field, value := range map[string]string{"created": issue.Fields.Created, "updated": issue.Fields.Updated} {
+		if t, err := jira.ParseTime(value); err == nil {
+			p[field] = t.Format(time.RFC3339)
+		}
+	}
+	return p
+}
+
 func save(file string, embeddings []*app.Embedding) error {
 	if len(embeddings) == 0 {
 		fmt.Println("no embeddings created")
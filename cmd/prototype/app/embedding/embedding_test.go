@@ -0,0 +1,139 @@
+package embedding
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadIssues(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		data     string
+		expected map[string]string
+	}{
+		{
+			desc:     "json array defaults source",
+			data:     `[{"key":"AA-1","fields":{"summary":"title"}},{"key":"DEV-1","source":"confluence"}]`,
+			expected: map[string]string{"AA-1": defaultSource, "DEV-1": "confluence"},
+		},
+		{
+			desc:     "json array with leading whitespace",
+			data:     "\n  [{\"key\":\"AA-1\"}]",
+			expected: map[string]string{"AA-1": defaultSource},
+		},
+		{
+			desc:     "json lines defaults source",
+			data:     "{\"key\":\"AA-1\"}\n{\"key\":\"DEV-1\",\"source\":\"confluence\"}\n",
+			expected: map[string]string{"AA-1": defaultSource, "DEV-1": "confluence"},
+		},
+		{
+			desc:     "empty file",
+			data:     "",
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "issues.json")
+			if err := os.WriteFile(file, []byte(tc.data), 0o644); err != nil {
+				t.Fatalf("failed to write file: %v", err)
+			}
+
+			issues, err := readIssues(file)
+			if err != nil {
+				t.Fatalf("failed to read issues: %v", err)
+			}
+			if len(issues) != len(tc.expected) {
+				t.Fatalf("expected %d issues, got %d", len(tc.expected), len(issues))
+			}
+			for _, issue := range issues {
+				if want := tc.expected[issue.Key]; issue.Source != want {
+					t.Errorf("expected %s source %q, got %q", issue.Key, want, issue.Source)
+				}
+			}
+		})
+	}
+}
+
+func TestReadIssuesInvalid(t *testing.T) {
+	for _, data := range []string{`[{"key":`, `{"key":"AA-1"} {`} {
+		file := filepath.Join(t.TempDir(), "issues.json")
+		if err := os.WriteFile(file, []byte(data), 0o644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		if _, err := readIssues(file); err == nil {
+			t.Errorf("expected error for %q, got nil", data)
+		}
+	}
+}
+
+func TestPayload(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		data     string
+		expected map[string]any
+	}{
+		{
+			desc: "jira issue",
+			data: `{"key":"AA-1","fields":{"summary":"title","labels":["backend"],"status":{"name":"Open"},"created":"2025-07-25T11:20:55.000+0900","updated":"2025-07-26T09:00:00.000+0900"}}`,
+			expected: map[string]any{
+				"key":     "AA-1",
+				"source":  defaultSource,
+				"title":   "title",
+				"value":   "text",
+				"status":  "Open",
+				"labels":  []any{"backend"},
+				"project": "AA",
+				"created": "2025-07-25T11:20:55+09:00",
+				"updated": "2025-07-26T09:00:00+09:00",
+			},
+		},
+		{
+			desc: "confluence page has no project",
+			data: `{"key":"confluence:123","source":"confluence","fields":{"summary":"page","updated":"2025-07-26T09:00:00.000+0900"}}`,
+			expected: map[string]any{
+				"key":     "confluence:123",
+				"source":  "confluence",
+				"title":   "page",
+				"value":   "text",
+				"status":  "",
+				"labels":  []any{},
+				"updated": "2025-07-26T09:00:00+09:00",
+			},
+		},
+		{
+			desc: "skip invalid dates",
+			data: `{"key":"AA-2","fields":{"created":"yesterday"}}`,
+			expected: map[string]any{
+				"key":     "AA-2",
+				"source":  defaultSource,
+				"title":   "",
+				"value":   "text",
+				"status":  "",
+				"labels":  []any{},
+				"project": "AA",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "issues.jsonl")
+			if err := os.WriteFile(file, []byte(tc.data), 0o644); err != nil {
+				t.Fatalf("failed to write file: %v", err)
+			}
+			issues, err := readIssues(file)
+			if err != nil {
+				t.Fatalf("failed to read issues: %v", err)
+			}
+
+			got := payload(&issues[0], "text")
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
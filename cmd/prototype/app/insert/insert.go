@@ -19,6 +19,8 @@ var (
 	name string
 	// 벡터 차원 크기.
 	dimension uint64 = 1024
+	// 저장할 벡터 이름과 희소 벡터 모델.
+	vectors Vectors
 )
 
 var insertCmd = &cobra.Command{
@@ -44,7 +46,7 @@ var insertCmd = &cobra.Command{
 			return
 		}
 
-		if err := Insert(ctx, address, name, dimension, embeddings); err != nil {
+		if err := Insert(ctx, address, name, dimension, vectors, embeddings); err != nil {
 			fmt.Println("error inserting data into Qdrant:", err)
 			return
 		}
@@ -58,6 +60,9 @@ func init() {
 	insertCmd.Flags().StringVarP(&address, "address", "a", "localhost:6334", "Qdrant server address")
 	insertCmd.Flags().StringVarP(&name, "name", "n", "default_collection", "Qdrant collection name")
 	insertCmd.Flags().Uint64VarP(&dimension, "dimension", "d", 1024, "Vector dimension size")
+	insertCmd.Flags().StringVar(&vectors.Dense, "dense-vector", "", "Dense vector name (empty uses the default vector)")
+	insertCmd.Flags().StringVar(&vectors.Sparse, "sparse-vector", "bm25", "Sparse vector name (empty disables sparse vectors)")
+	insertCmd.Flags().StringVar(&vectors.SparseModel, "sparse-model", "qdrant/bm25", "Qdrant inference model for sparse vectors")
 
 	_ = insertCmd.MarkFlagRequired("file")
 
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"log/slog"
 	"net"
 	"slices"
@@ -14,11 +15,24 @@ import (
 	"github.com/devafterdark/project-lumos/cmd/prototype/app"
 )
 
+// 저장할 벡터 이름과 희소 벡터 모델.
+type Vectors struct {
+	// 밀집 벡터 이름. 비어 있으면 컬렉션의 기본 벡터에 저장합니다.
+	Dense string
+	// 희소 벡터 이름. 비어 있으면 희소 벡터를 저장하지 않습니다.
+	Sparse string
+	// 패시지 원문으로 희소 벡터를 만드는 Qdrant 추론 모델. 검색할 때와 같은 모델이어야 합니다.
+	SparseModel string
+}
+
+// 밀집 벡터와 함께 패시지 원문("value")을 Qdrant가 희소 벡터로 바꿔 저장합니다.
+// 희소 벡터가 없는 기존 컬렉션에는 밀집 벡터만 저장합니다.
 func Insert(
 	ctx context.Context,
 	address string,
 	name string,
 	dimension uint64,
+	vectors Vectors,
 	embeddings []app.Embedding,
 ) error {
 	client, err := createClient(address)
@@ -31,16 +45,27 @@ func Insert(
 		}
 	}()
 
-	if err := createCollection(ctx, client, name, dimension); err != nil {
+	if err := createCollection(ctx, client, name, dimension, vectors); err != nil {
 		return err
 	}
+	if vectors.Sparse != "" {
+		ok, err := hasSparseVector(ctx, client, name, vectors.Sparse)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			slog.Warn("collection has no sparse vector, inserting dense vectors only",
+				slog.String("collection", name), slog.String("vector", vectors.Sparse))
+			vectors.Sparse = ""
+		}
+	}
 
 	points := make([]*qdrant.PointStruct, 0, len(embeddings))
 	for _, embedding := range embeddings {
 		points = append(points, &qdrant.PointStruct{
 			Id:      qdrant.NewIDUUID(uuid.NewString()),
 			Payload: qdrant.NewValueMap(embedding.Payload),
-			Vectors: qdrant.NewVectors(embedding.Vectors...),
+			Vectors: pointVectors(vectors, embedding),
 		})
 	}
 
@@ -74,7 +99,36 @@ func createClient(address string) (*qdrant.Client, error) {
 	})
 }
 
-func createCollection(ctx context.Context, client *qdrant.Client, name string, dimension uint64) error {
+// 이름 붙은 벡터를 쓰지 않으면 기본 벡터만 저장합니다.
+func pointVectors(vectors Vectors, embedding app.Embedding) *qdrant.Vectors {
+	if vectors.Dense == "" && vectors.Sparse == "" {
+		return qdrant.NewVectors(embedding.Vectors...)
+	}
+
+	named := map[string]*qdrant.Vector{
+		vectors.Dense: qdrant.NewVectorDense(embedding.Vectors),
+	}
+	if vectors.Sparse != "" {
+		text, _ := embedding.Payload["value"].(string)
+		named[vectors.Sparse] = qdrant.NewVectorDocument(&qdrant.Document{
+			Text:  text,
+			Model: vectors.SparseModel,
+		})
+	}
+	return qdrant.NewVectorsMap(named)
+}
+
+func hasSparseVector(ctx context.Context, client *qdrant.Client, name, vector string) (bool, error) {
+	info, err := client.GetCollectionInfo(ctx, name)
+	if err != nil {
+		return false, fmt.Errorf("failed to get collection info: %w", err)
+	}
+	_, ok := info.GetConfig().GetParams().GetSparseVectorsConfig().GetMap()[vector]
+	return ok, nil
+}
+
+// 희소 벡터는 BM25 점수가 되도록 IDF 보정을 사용합니다.
+func createCollection(ctx context.Context, client *qdrant.Client, name string, dimension uint64, vectors Vectors) error {
 	names, err := client.ListCollections(ctx)
 	if err != nil {
 		return err
@@ -84,13 +138,22 @@ func createCollection(ctx context.Context, client *qdrant.Client, name string, d
 		return nil
 	}
 
-	return client.CreateCollection(ctx, &qdrant.CreateCollection{
+	dense := &qdrant.VectorParams{
+		Size:     dimension,
+		Distance: qdrant.Distance_Cosine,
+	}
+	collection := &qdrant.CreateCollection{
 		CollectionName: name,
-		VectorsConfig: qdrant.NewVectorsConfig(
-			&qdrant.VectorParams{
-				Size:     dimension,
-				Distance: qdrant.Distance_Cosine,
-			},
-		),
-	})
+		VectorsConfig:  qdrant.NewVectorsConfig(dense),
+	}
+	if vectors.Dense != "" {
+		collection.VectorsConfig = qdrant.NewVectorsConfigMap(map[string]*qdrant.VectorParams{vectors.Dense: dense})
+	}
+	if vectors.Sparse != "" {
+		collection.SparseVectorsConfig = qdrant.NewSparseVectorsConfig(map[string]*qdrant.SparseVectorParams{
+			vectors.Sparse: {Modifier: qdrant.Modifier_Idf.Enum()},
+		})
+	}
+
+	return client.CreateCollection(ctx, collection)
 }
@@ -9,6 +9,7 @@ package issue
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -113,6 +114,418 @@ func (x *RetrieveResponse) GetIssues() []*Issue {
 	return nil
 }
 
+// 검색어 검색 요청 메시지.
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색어. 모든 단어가 제목, 내용, 댓글 중 한 곳에 포함된 이슈를 찾습니다.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 검색 조건.
+	Filter *IssueFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 한 페이지의 최대 이슈 수. 0이면 기본값을 사용합니다.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 이전 응답의 next_page_token. 비어 있으면 첫 페이지를 조회합니다.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetFilter() *IssueFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// 검색어 검색 응답 메시지.
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색된 이슈 목록.
+	Issues []*Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// 다음 페이지 토큰. 마지막 페이지이면 비어 있습니다.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 조건에 맞는 전체 이슈 수.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// 이슈 목록 요청 메시지.
+type ListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색 조건.
+	Filter *IssueFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 한 페이지의 최대 이슈 수. 0이면 기본값을 사용합니다.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 이전 응답의 next_page_token. 비어 있으면 첫 페이지를 조회합니다.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRequest) GetFilter() *IssueFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// 이슈 목록 응답 메시지.
+type ListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 조건에 맞는 이슈 목록.
+	Issues []*Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// 다음 페이지 토큰. 마지막 페이지이면 비어 있습니다.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 조건에 맞는 전체 이슈 수.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// 이슈 검색 조건.
+// 목록 조건은 값 중 하나라도 일치하면 만족하며, 설정한 조건을 모두 만족하는 이슈만 찾습니다.
+type IssueFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 프로젝트 키 목록. e.g., "AA"
+	Projects []string `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// 이슈 상태 목록. 대소문자를 구분하지 않습니다. e.g., "Open"
+	Statuses []string `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// 레이블 목록.
+	Labels []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	// 할당자 목록. 사용자 ID, 표시 이름, 이메일 주소 중 하나와 일치하면 됩니다.
+	Assignees []string `protobuf:"bytes,4,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	// 이슈 생성일 범위.
+	Created *TimeRange `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	// 이슈 수정일 범위.
+	Updated       *TimeRange `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueFilter) Reset() {
+	*x = IssueFilter{}
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueFilter) ProtoMessage() {}
+
+func (x *IssueFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueFilter.ProtoReflect.Descriptor instead.
+func (*IssueFilter) Descriptor() ([]byte, []int) {
+	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IssueFilter) GetProjects() []string {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *IssueFilter) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *IssueFilter) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *IssueFilter) GetAssignees() []string {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+func (x *IssueFilter) GetCreated() *TimeRange {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *IssueFilter) GetUpdated() *TimeRange {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+// 시간 범위. 시작 시각은 포함하고 종료 시각은 포함하지 않습니다.
+type TimeRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 시작 시각. 없으면 제한하지 않습니다.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// 종료 시각. 없으면 제한하지 않습니다.
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TimeRange) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TimeRange) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
 // 이슈 정보.
 type Issue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -123,14 +536,26 @@ type Issue struct {
 	// 이슈 내용.
 	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	// 이슈 댓글.
-	Comments      []string `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	Comments []string `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	// 이슈 상태. e.g., "Open"
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// 레이블 목록.
+	Labels []string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`
+	// 할당자 표시 이름. 할당되지 않았으면 비어 있습니다.
+	Assignee string `protobuf:"bytes,7,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	// 이슈 웹 주소.
+	Url string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	// 이슈 생성일.
+	Created *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
+	// 이슈 수정일.
+	Updated       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated,proto3" json:"updated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Issue) Reset() {
 	*x = Issue{}
-	mi := &file_retrieval_issue_v1_service_proto_msgTypes[2]
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -142,7 +567,7 @@ func (x *Issue) String() string {
 func (*Issue) ProtoMessage() {}
 
 func (x *Issue) ProtoReflect() protoreflect.Message {
-	mi := &file_retrieval_issue_v1_service_proto_msgTypes[2]
+	mi := &file_retrieval_issue_v1_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +580,7 @@ func (x *Issue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Issue.ProtoReflect.Descriptor instead.
 func (*Issue) Descriptor() ([]byte, []int) {
-	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{2}
+	return file_retrieval_issue_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Issue) GetKey() string {
@@ -186,23 +611,105 @@ func (x *Issue) GetComments() []string {
 	return nil
 }
 
+func (x *Issue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Issue) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Issue) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *Issue) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Issue) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Issue) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
 var File_retrieval_issue_v1_service_proto protoreflect.FileDescriptor
 
 const file_retrieval_issue_v1_service_proto_rawDesc = "" +
 	"\n" +
-	" retrieval/issue/v1/service.proto\x12\x12retrieval.issue.v1\"0\n" +
+	" retrieval/issue/v1/service.proto\x12\x12retrieval.issue.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"0\n" +
 	"\x0fRetrieveRequest\x12\x1d\n" +
 	"\n" +
 	"issue_keys\x18\x01 \x03(\tR\tissueKeys\"E\n" +
 	"\x10RetrieveResponse\x121\n" +
-	"\x06issues\x18\x01 \x03(\v2\x19.retrieval.issue.v1.IssueR\x06issues\"e\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.retrieval.issue.v1.IssueR\x06issues\"\x9a\x01\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x127\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1f.retrieval.issue.v1.IssueFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x8a\x01\n" +
+	"\x0eSearchResponse\x121\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.retrieval.issue.v1.IssueR\x06issues\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\x82\x01\n" +
+	"\vListRequest\x127\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1f.retrieval.issue.v1.IssueFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\fListResponse\x121\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.retrieval.issue.v1.IssueR\x06issues\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xed\x01\n" +
+	"\vIssueFilter\x12\x1a\n" +
+	"\bprojects\x18\x01 \x03(\tR\bprojects\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\tR\bstatuses\x12\x16\n" +
+	"\x06labels\x18\x03 \x03(\tR\x06labels\x12\x1c\n" +
+	"\tassignees\x18\x04 \x03(\tR\tassignees\x127\n" +
+	"\acreated\x18\x05 \x01(\v2\x1d.retrieval.issue.v1.TimeRangeR\acreated\x127\n" +
+	"\aupdated\x18\x06 \x01(\v2\x1d.retrieval.issue.v1.TimeRangeR\aupdated\"k\n" +
+	"\tTimeRange\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\xaf\x02\n" +
 	"\x05Issue\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1a\n" +
-	"\bcomments\x18\x04 \x03(\tR\bcomments2n\n" +
+	"\bcomments\x18\x04 \x03(\tR\bcomments\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06labels\x18\x06 \x03(\tR\x06labels\x12\x1a\n" +
+	"\bassignee\x18\a \x01(\tR\bassignee\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x124\n" +
+	"\acreated\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
+	"\aupdated\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aupdated2\x8a\x02\n" +
 	"\x15IssueRetrievalService\x12U\n" +
-	"\bRetrieve\x12#.retrieval.issue.v1.RetrieveRequest\x1a$.retrieval.issue.v1.RetrieveResponseBFZDgithub.com/devafterdark/project-lumos/proto/retrieval/issue/v1;issueb\x06proto3"
+	"\bRetrieve\x12#.retrieval.issue.v1.RetrieveRequest\x1a$.retrieval.issue.v1.RetrieveResponse\x12O\n" +
+	"\x06Search\x12!.retrieval.issue.v1.SearchRequest\x1a\".retrieval.issue.v1.SearchResponse\x12I\n" +
+	"\x04List\x12\x1f.retrieval.issue.v1.ListRequest\x1a .retrieval.issue.v1.ListResponseBFZDgithub.com/devafterdark/project-lumos/proto/retrieval/issue/v1;issueb\x06proto3"
 
 var (
 	file_retrieval_issue_v1_service_proto_rawDescOnce sync.Once
@@ -216,21 +723,42 @@ func file_retrieval_issue_v1_service_proto_rawDescGZIP() []byte {
 	return file_retrieval_issue_v1_service_proto_rawDescData
 }
 
-var file_retrieval_issue_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_retrieval_issue_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_retrieval_issue_v1_service_proto_goTypes = []any{
-	(*RetrieveRequest)(nil),  // 0: retrieval.issue.v1.RetrieveRequest
-	(*RetrieveResponse)(nil), // 1: retrieval.issue.v1.RetrieveResponse
-	(*Issue)(nil),            // 2: retrieval.issue.v1.Issue
+	(*RetrieveRequest)(nil),       // 0: retrieval.issue.v1.RetrieveRequest
+	(*RetrieveResponse)(nil),      // 1: retrieval.issue.v1.RetrieveResponse
+	(*SearchRequest)(nil),         // 2: retrieval.issue.v1.SearchRequest
+	(*SearchResponse)(nil),        // 3: retrieval.issue.v1.SearchResponse
+	(*ListRequest)(nil),           // 4: retrieval.issue.v1.ListRequest
+	(*ListResponse)(nil),          // 5: retrieval.issue.v1.ListResponse
+	(*IssueFilter)(nil),           // 6: retrieval.issue.v1.IssueFilter
+	(*TimeRange)(nil),             // 7: retrieval.issue.v1.TimeRange
+	(*Issue)(nil),                 // 8: retrieval.issue.v1.Issue
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
 }
 var file_retrieval_issue_v1_service_proto_depIdxs = []int32{
-	2, // 0: retrieval.issue.v1.RetrieveResponse.issues:type_name -> retrieval.issue.v1.Issue
-	0, // 1: retrieval.issue.v1.IssueRetrievalService.Retrieve:input_type -> retrieval.issue.v1.RetrieveRequest
-	1, // 2: retrieval.issue.v1.IssueRetrievalService.Retrieve:output_type -> retrieval.issue.v1.RetrieveResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: retrieval.issue.v1.RetrieveResponse.issues:type_name -> retrieval.issue.v1.Issue
+	6,  // 1: retrieval.issue.v1.SearchRequest.filter:type_name -> retrieval.issue.v1.IssueFilter
+	8,  // 2: retrieval.issue.v1.SearchResponse.issues:type_name -> retrieval.issue.v1.Issue
+	6,  // 3: retrieval.issue.v1.ListRequest.filter:type_name -> retrieval.issue.v1.IssueFilter
+	8,  // 4: retrieval.issue.v1.ListResponse.issues:type_name -> retrieval.issue.v1.Issue
+	7,  // 5: retrieval.issue.v1.IssueFilter.created:type_name -> retrieval.issue.v1.TimeRange
+	7,  // 6: retrieval.issue.v1.IssueFilter.updated:type_name -> retrieval.issue.v1.TimeRange
+	9,  // 7: retrieval.issue.v1.TimeRange.start:type_name -> google.protobuf.Timestamp
+	9,  // 8: retrieval.issue.v1.TimeRange.end:type_name -> google.protobuf.Timestamp
+	9,  // 9: retrieval.issue.v1.Issue.created:type_name -> google.protobuf.Timestamp
+	9,  // 10: retrieval.issue.v1.Issue.updated:type_name -> google.protobuf.Timestamp
+	0,  // 11: retrieval.issue.v1.IssueRetrievalService.Retrieve:input_type -> retrieval.issue.v1.RetrieveRequest
+	2,  // 12: retrieval.issue.v1.IssueRetrievalService.Search:input_type -> retrieval.issue.v1.SearchRequest
+	4,  // 13: retrieval.issue.v1.IssueRetrievalService.List:input_type -> retrieval.issue.v1.ListRequest
+	1,  // 14: retrieval.issue.v1.IssueRetrievalService.Retrieve:output_type -> retrieval.issue.v1.RetrieveResponse
+	3,  // 15: retrieval.issue.v1.IssueRetrievalService.Search:output_type -> retrieval.issue.v1.SearchResponse
+	5,  // 16: retrieval.issue.v1.IssueRetrievalService.List:output_type -> retrieval.issue.v1.ListResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_retrieval_issue_v1_service_proto_init() }
@@ -244,7 +772,7 @@ func file_retrieval_issue_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_retrieval_issue_v1_service_proto_rawDesc), len(file_retrieval_issue_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
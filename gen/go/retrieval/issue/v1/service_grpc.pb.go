@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	IssueRetrievalService_Retrieve_FullMethodName = "/retrieval.issue.v1.IssueRetrievalService/Retrieve"
+	IssueRetrievalService_Search_FullMethodName   = "/retrieval.issue.v1.IssueRetrievalService/Search"
+	IssueRetrievalService_List_FullMethodName     = "/retrieval.issue.v1.IssueRetrievalService/List"
 )
 
 // IssueRetrievalServiceClient is the client API for IssueRetrievalService service.
@@ -30,6 +32,10 @@ const (
 type IssueRetrievalServiceClient interface {
 	// 이슈를 검색하고 찾은 결과를 반환합니다.
 	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error)
+	// 검색어가 포함된 이슈를 관련도 순서로 반환합니다.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// 조건에 맞는 이슈를 최근 수정한 순서로 반환합니다.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 }
 
 type issueRetrievalServiceClient struct {
@@ -50,6 +56,26 @@ func (c *issueRetrievalServiceClient) Retrieve(ctx context.Context, in *Retrieve
 	return out, nil
 }
 
+func (c *issueRetrievalServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, IssueRetrievalService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueRetrievalServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, IssueRetrievalService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssueRetrievalServiceServer is the server API for IssueRetrievalService service.
 // All implementations must embed UnimplementedIssueRetrievalServiceServer
 // for forward compatibility.
@@ -58,6 +84,10 @@ func (c *issueRetrievalServiceClient) Retrieve(ctx context.Context, in *Retrieve
 type IssueRetrievalServiceServer interface {
 	// 이슈를 검색하고 찾은 결과를 반환합니다.
 	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error)
+	// 검색어가 포함된 이슈를 관련도 순서로 반환합니다.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// 조건에 맞는 이슈를 최근 수정한 순서로 반환합니다.
+	List(context.Context, *ListRequest) (*ListResponse, error)
 	mustEmbedUnimplementedIssueRetrievalServiceServer()
 }
 
@@ -71,6 +101,12 @@ type UnimplementedIssueRetrievalServiceServer struct{}
 func (UnimplementedIssueRetrievalServiceServer) Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
 }
+func (UnimplementedIssueRetrievalServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedIssueRetrievalServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
 func (UnimplementedIssueRetrievalServiceServer) mustEmbedUnimplementedIssueRetrievalServiceServer() {}
 func (UnimplementedIssueRetrievalServiceServer) testEmbeddedByValue()                               {}
 
@@ -110,6 +146,42 @@ func _IssueRetrievalService_Retrieve_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueRetrievalService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueRetrievalServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueRetrievalService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueRetrievalServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueRetrievalService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueRetrievalServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueRetrievalService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueRetrievalServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssueRetrievalService_ServiceDesc is the grpc.ServiceDesc for IssueRetrievalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +193,14 @@ var IssueRetrievalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Retrieve",
 			Handler:    _IssueRetrievalService_Retrieve_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _IssueRetrievalService_Search_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _IssueRetrievalService_List_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "retrieval/issue/v1/service.proto",
@@ -9,6 +9,8 @@ package passage
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,7 +29,9 @@ type RetrieveRequest struct {
 	// 검색 쿼리.
 	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	// 검색 결과 수 제한.
-	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 패시지 메타데이터 조건. 없으면 모든 패시지를 검색합니다.
+	Filter        *Filter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,6 +80,13 @@ func (x *RetrieveRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *RetrieveRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 // 패시지 검색 응답 메시지.
 type RetrieveResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -122,6 +133,52 @@ func (x *RetrieveResponse) GetPassages() []*Passage {
 	return nil
 }
 
+// 패시지 스트리밍 검색 응답 메시지.
+type RetrieveStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색된 패시지.
+	Passage       *Passage `protobuf:"bytes,1,opt,name=passage,proto3" json:"passage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveStreamResponse) Reset() {
+	*x = RetrieveStreamResponse{}
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveStreamResponse) ProtoMessage() {}
+
+func (x *RetrieveStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveStreamResponse.ProtoReflect.Descriptor instead.
+func (*RetrieveStreamResponse) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetrieveStreamResponse) GetPassage() *Passage {
+	if x != nil {
+		return x.Passage
+	}
+	return nil
+}
+
 // 패시지 정보.
 type Passage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -135,7 +192,7 @@ type Passage struct {
 
 func (x *Passage) Reset() {
 	*x = Passage{}
-	mi := &file_retrieval_passage_v1_service_proto_msgTypes[2]
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +204,7 @@ func (x *Passage) String() string {
 func (*Passage) ProtoMessage() {}
 
 func (x *Passage) ProtoReflect() protoreflect.Message {
-	mi := &file_retrieval_passage_v1_service_proto_msgTypes[2]
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +217,7 @@ func (x *Passage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Passage.ProtoReflect.Descriptor instead.
 func (*Passage) Descriptor() ([]byte, []int) {
-	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{2}
+	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Passage) GetScore() float32 {
@@ -177,21 +234,479 @@ func (x *Passage) GetContent() []byte {
 	return nil
 }
 
+// 패시지 메타데이터 조건.
+// must 조건은 모두, should 조건은 하나 이상 만족해야 하며, must_not 조건은 하나도 만족하면 안 됩니다.
+type Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 모두 만족해야 하는 조건.
+	Must []*Condition `protobuf:"bytes,1,rep,name=must,proto3" json:"must,omitempty"`
+	// 하나 이상 만족해야 하는 조건.
+	Should []*Condition `protobuf:"bytes,2,rep,name=should,proto3" json:"should,omitempty"`
+	// 만족하면 안 되는 조건.
+	MustNot       []*Condition `protobuf:"bytes,3,rep,name=must_not,json=mustNot,proto3" json:"must_not,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Filter) GetMust() []*Condition {
+	if x != nil {
+		return x.Must
+	}
+	return nil
+}
+
+func (x *Filter) GetShould() []*Condition {
+	if x != nil {
+		return x.Should
+	}
+	return nil
+}
+
+func (x *Filter) GetMustNot() []*Condition {
+	if x != nil {
+		return x.MustNot
+	}
+	return nil
+}
+
+// 메타데이터 필드 하나에 대한 조건.
+type Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 메타데이터 필드 이름. e.g., "key", "project", "status", "labels", "updated"
+	// 값이 목록인 필드(labels)는 값 중 하나라도 조건을 만족하면 됩니다.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Types that are valid to be assigned to Condition:
+	//
+	//	*Condition_Keyword
+	//	*Condition_Keywords
+	//	*Condition_Integer
+	//	*Condition_Boolean
+	//	*Condition_Range
+	//	*Condition_DatetimeRange
+	//	*Condition_Filter
+	Condition     isCondition_Condition `protobuf_oneof:"condition"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Condition) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Condition) GetCondition() isCondition_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *Condition) GetKeyword() string {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Keyword); ok {
+			return x.Keyword
+		}
+	}
+	return ""
+}
+
+func (x *Condition) GetKeywords() *Keywords {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Keywords); ok {
+			return x.Keywords
+		}
+	}
+	return nil
+}
+
+func (x *Condition) GetInteger() int64 {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Integer); ok {
+			return x.Integer
+		}
+	}
+	return 0
+}
+
+func (x *Condition) GetBoolean() bool {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Boolean); ok {
+			return x.Boolean
+		}
+	}
+	return false
+}
+
+func (x *Condition) GetRange() *Range {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Range); ok {
+			return x.Range
+		}
+	}
+	return nil
+}
+
+func (x *Condition) GetDatetimeRange() *DatetimeRange {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_DatetimeRange); ok {
+			return x.DatetimeRange
+		}
+	}
+	return nil
+}
+
+func (x *Condition) GetFilter() *Filter {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Filter); ok {
+			return x.Filter
+		}
+	}
+	return nil
+}
+
+type isCondition_Condition interface {
+	isCondition_Condition()
+}
+
+type Condition_Keyword struct {
+	// 값이 일치하는 패시지.
+	Keyword string `protobuf:"bytes,2,opt,name=keyword,proto3,oneof"`
+}
+
+type Condition_Keywords struct {
+	// 값이 목록 중 하나와 일치하는 패시지.
+	Keywords *Keywords `protobuf:"bytes,3,opt,name=keywords,proto3,oneof"`
+}
+
+type Condition_Integer struct {
+	// 정수 값이 일치하는 패시지.
+	Integer int64 `protobuf:"varint,4,opt,name=integer,proto3,oneof"`
+}
+
+type Condition_Boolean struct {
+	// 불리언 값이 일치하는 패시지.
+	Boolean bool `protobuf:"varint,5,opt,name=boolean,proto3,oneof"`
+}
+
+type Condition_Range struct {
+	// 숫자 값이 범위 안에 있는 패시지.
+	Range *Range `protobuf:"bytes,6,opt,name=range,proto3,oneof"`
+}
+
+type Condition_DatetimeRange struct {
+	// 날짜 값(RFC 3339)이 범위 안에 있는 패시지.
+	DatetimeRange *DatetimeRange `protobuf:"bytes,7,opt,name=datetime_range,json=datetimeRange,proto3,oneof"`
+}
+
+type Condition_Filter struct {
+	// 하위 조건. field는 사용하지 않습니다.
+	Filter *Filter `protobuf:"bytes,8,opt,name=filter,proto3,oneof"`
+}
+
+func (*Condition_Keyword) isCondition_Condition() {}
+
+func (*Condition_Keywords) isCondition_Condition() {}
+
+func (*Condition_Integer) isCondition_Condition() {}
+
+func (*Condition_Boolean) isCondition_Condition() {}
+
+func (*Condition_Range) isCondition_Condition() {}
+
+func (*Condition_DatetimeRange) isCondition_Condition() {}
+
+func (*Condition_Filter) isCondition_Condition() {}
+
+// 키워드 목록.
+type Keywords struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Keywords) Reset() {
+	*x = Keywords{}
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Keywords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keywords) ProtoMessage() {}
+
+func (x *Keywords) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keywords.ProtoReflect.Descriptor instead.
+func (*Keywords) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Keywords) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 숫자 범위. 설정하지 않은 경계는 제한하지 않습니다.
+type Range struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Gt            *wrapperspb.DoubleValue `protobuf:"bytes,1,opt,name=gt,proto3" json:"gt,omitempty"`
+	Gte           *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lt            *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=lt,proto3" json:"lt,omitempty"`
+	Lte           *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Range) GetGt() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *Range) GetGte() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *Range) GetLt() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *Range) GetLte() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+// 날짜 범위. 설정하지 않은 경계는 제한하지 않습니다.
+type DatetimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=gt,proto3" json:"gt,omitempty"`
+	Gte           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lt,proto3" json:"lt,omitempty"`
+	Lte           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatetimeRange) Reset() {
+	*x = DatetimeRange{}
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatetimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatetimeRange) ProtoMessage() {}
+
+func (x *DatetimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatetimeRange.ProtoReflect.Descriptor instead.
+func (*DatetimeRange) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DatetimeRange) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *DatetimeRange) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *DatetimeRange) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *DatetimeRange) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
 var File_retrieval_passage_v1_service_proto protoreflect.FileDescriptor
 
 const file_retrieval_passage_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\"retrieval/passage/v1/service.proto\x12\x14retrieval.passage.v1\"=\n" +
+	"\"retrieval/passage/v1/service.proto\x12\x14retrieval.passage.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"s\n" +
 	"\x0fRetrieveRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"M\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.retrieval.passage.v1.FilterR\x06filter\"M\n" +
 	"\x10RetrieveResponse\x129\n" +
-	"\bpassages\x18\x01 \x03(\v2\x1d.retrieval.passage.v1.PassageR\bpassages\"9\n" +
+	"\bpassages\x18\x01 \x03(\v2\x1d.retrieval.passage.v1.PassageR\bpassages\"Q\n" +
+	"\x16RetrieveStreamResponse\x127\n" +
+	"\apassage\x18\x01 \x01(\v2\x1d.retrieval.passage.v1.PassageR\apassage\"9\n" +
 	"\aPassage\x12\x14\n" +
 	"\x05score\x18\x01 \x01(\x02R\x05score\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\fR\acontent2t\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\xb2\x01\n" +
+	"\x06Filter\x123\n" +
+	"\x04must\x18\x01 \x03(\v2\x1f.retrieval.passage.v1.ConditionR\x04must\x127\n" +
+	"\x06should\x18\x02 \x03(\v2\x1f.retrieval.passage.v1.ConditionR\x06should\x12:\n" +
+	"\bmust_not\x18\x03 \x03(\v2\x1f.retrieval.passage.v1.ConditionR\amustNot\"\xfb\x02\n" +
+	"\tCondition\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\akeyword\x18\x02 \x01(\tH\x00R\akeyword\x12<\n" +
+	"\bkeywords\x18\x03 \x01(\v2\x1e.retrieval.passage.v1.KeywordsH\x00R\bkeywords\x12\x1a\n" +
+	"\ainteger\x18\x04 \x01(\x03H\x00R\ainteger\x12\x1a\n" +
+	"\aboolean\x18\x05 \x01(\bH\x00R\aboolean\x123\n" +
+	"\x05range\x18\x06 \x01(\v2\x1b.retrieval.passage.v1.RangeH\x00R\x05range\x12L\n" +
+	"\x0edatetime_range\x18\a \x01(\v2#.retrieval.passage.v1.DatetimeRangeH\x00R\rdatetimeRange\x126\n" +
+	"\x06filter\x18\b \x01(\v2\x1c.retrieval.passage.v1.FilterH\x00R\x06filterB\v\n" +
+	"\tcondition\"\"\n" +
+	"\bKeywords\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xc3\x01\n" +
+	"\x05Range\x12,\n" +
+	"\x02gt\x18\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02gt\x12.\n" +
+	"\x03gte\x18\x02 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03gte\x12,\n" +
+	"\x02lt\x18\x03 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02lt\x12.\n" +
+	"\x03lte\x18\x04 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03lte\"\xc3\x01\n" +
+	"\rDatetimeRange\x12*\n" +
+	"\x02gt\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02gt\x12,\n" +
+	"\x03gte\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03gte\x12*\n" +
+	"\x02lt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02lt\x12,\n" +
+	"\x03lte\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03lte2\xdd\x01\n" +
 	"\x17PassageRetrievalService\x12Y\n" +
-	"\bRetrieve\x12%.retrieval.passage.v1.RetrieveRequest\x1a&.retrieval.passage.v1.RetrieveResponseBJZHgithub.com/devafterdark/project-lumos/proto/retrieval/passage/v1;passageb\x06proto3"
+	"\bRetrieve\x12%.retrieval.passage.v1.RetrieveRequest\x1a&.retrieval.passage.v1.RetrieveResponse\x12g\n" +
+	"\x0eRetrieveStream\x12%.retrieval.passage.v1.RetrieveRequest\x1a,.retrieval.passage.v1.RetrieveStreamResponse0\x01BJZHgithub.com/devafterdark/project-lumos/proto/retrieval/passage/v1;passageb\x06proto3"
 
 var (
 	file_retrieval_passage_v1_service_proto_rawDescOnce sync.Once
@@ -205,21 +720,48 @@ func file_retrieval_passage_v1_service_proto_rawDescGZIP() []byte {
 	return file_retrieval_passage_v1_service_proto_rawDescData
 }
 
-var file_retrieval_passage_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_retrieval_passage_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_retrieval_passage_v1_service_proto_goTypes = []any{
-	(*RetrieveRequest)(nil),  // 0: retrieval.passage.v1.RetrieveRequest
-	(*RetrieveResponse)(nil), // 1: retrieval.passage.v1.RetrieveResponse
-	(*Passage)(nil),          // 2: retrieval.passage.v1.Passage
+	(*RetrieveRequest)(nil),        // 0: retrieval.passage.v1.RetrieveRequest
+	(*RetrieveResponse)(nil),       // 1: retrieval.passage.v1.RetrieveResponse
+	(*RetrieveStreamResponse)(nil), // 2: retrieval.passage.v1.RetrieveStreamResponse
+	(*Passage)(nil),                // 3: retrieval.passage.v1.Passage
+	(*Filter)(nil),                 // 4: retrieval.passage.v1.Filter
+	(*Condition)(nil),              // 5: retrieval.passage.v1.Condition
+	(*Keywords)(nil),               // 6: retrieval.passage.v1.Keywords
+	(*Range)(nil),                  // 7: retrieval.passage.v1.Range
+	(*DatetimeRange)(nil),          // 8: retrieval.passage.v1.DatetimeRange
+	(*wrapperspb.DoubleValue)(nil), // 9: google.protobuf.DoubleValue
+	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
 }
 var file_retrieval_passage_v1_service_proto_depIdxs = []int32{
-	2, // 0: retrieval.passage.v1.RetrieveResponse.passages:type_name -> retrieval.passage.v1.Passage
-	0, // 1: retrieval.passage.v1.PassageRetrievalService.Retrieve:input_type -> retrieval.passage.v1.RetrieveRequest
-	1, // 2: retrieval.passage.v1.PassageRetrievalService.Retrieve:output_type -> retrieval.passage.v1.RetrieveResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4,  // 0: retrieval.passage.v1.RetrieveRequest.filter:type_name -> retrieval.passage.v1.Filter
+	3,  // 1: retrieval.passage.v1.RetrieveResponse.passages:type_name -> retrieval.passage.v1.Passage
+	3,  // 2: retrieval.passage.v1.RetrieveStreamResponse.passage:type_name -> retrieval.passage.v1.Passage
+	5,  // 3: retrieval.passage.v1.Filter.must:type_name -> retrieval.passage.v1.Condition
+	5,  // 4: retrieval.passage.v1.Filter.should:type_name -> retrieval.passage.v1.Condition
+	5,  // 5: retrieval.passage.v1.Filter.must_not:type_name -> retrieval.passage.v1.Condition
+	6,  // 6: retrieval.passage.v1.Condition.keywords:type_name -> retrieval.passage.v1.Keywords
+	7,  // 7: retrieval.passage.v1.Condition.range:type_name -> retrieval.passage.v1.Range
+	8,  // 8: retrieval.passage.v1.Condition.datetime_range:type_name -> retrieval.passage.v1.DatetimeRange
+	4,  // 9: retrieval.passage.v1.Condition.filter:type_name -> retrieval.passage.v1.Filter
+	9,  // 10: retrieval.passage.v1.Range.gt:type_name -> google.protobuf.DoubleValue
+	9,  // 11: retrieval.passage.v1.Range.gte:type_name -> google.protobuf.DoubleValue
+	9,  // 12: retrieval.passage.v1.Range.lt:type_name -> google.protobuf.DoubleValue
+	9,  // 13: retrieval.passage.v1.Range.lte:type_name -> google.protobuf.DoubleValue
+	10, // 14: retrieval.passage.v1.DatetimeRange.gt:type_name -> google.protobuf.Timestamp
+	10, // 15: retrieval.passage.v1.DatetimeRange.gte:type_name -> google.protobuf.Timestamp
+	10, // 16: retrieval.passage.v1.DatetimeRange.lt:type_name -> google.protobuf.Timestamp
+	10, // 17: retrieval.passage.v1.DatetimeRange.lte:type_name -> google.protobuf.Timestamp
+	0,  // 18: retrieval.passage.v1.PassageRetrievalService.Retrieve:input_type -> retrieval.passage.v1.RetrieveRequest
+	0,  // 19: retrieval.passage.v1.PassageRetrievalService.RetrieveStream:input_type -> retrieval.passage.v1.RetrieveRequest
+	1,  // 20: retrieval.passage.v1.PassageRetrievalService.Retrieve:output_type -> retrieval.passage.v1.RetrieveResponse
+	2,  // 21: retrieval.passage.v1.PassageRetrievalService.RetrieveStream:output_type -> retrieval.passage.v1.RetrieveStreamResponse
+	20, // [20:22] is the sub-list for method output_type
+	18, // [18:20] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_retrieval_passage_v1_service_proto_init() }
@@ -227,13 +769,22 @@ func file_retrieval_passage_v1_service_proto_init() {
 	if File_retrieval_passage_v1_service_proto != nil {
 		return
 	}
+	file_retrieval_passage_v1_service_proto_msgTypes[5].OneofWrappers = []any{
+		(*Condition_Keyword)(nil),
+		(*Condition_Keywords)(nil),
+		(*Condition_Integer)(nil),
+		(*Condition_Boolean)(nil),
+		(*Condition_Range)(nil),
+		(*Condition_DatetimeRange)(nil),
+		(*Condition_Filter)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_retrieval_passage_v1_service_proto_rawDesc), len(file_retrieval_passage_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PassageRetrievalService_Retrieve_FullMethodName = "/retrieval.passage.v1.PassageRetrievalService/Retrieve"
+	PassageRetrievalService_Retrieve_FullMethodName       = "/retrieval.passage.v1.PassageRetrievalService/Retrieve"
+	PassageRetrievalService_RetrieveStream_FullMethodName = "/retrieval.passage.v1.PassageRetrievalService/RetrieveStream"
 )
 
 // PassageRetrievalServiceClient is the client API for PassageRetrievalService service.
@@ -30,6 +31,10 @@ const (
 type PassageRetrievalServiceClient interface {
 	// 패시지를 검색하고 찾은 결과를 반환합니다.
 	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error)
+	// 패시지를 검색하고 찾은 결과를 점수가 높은 순서로 하나씩 보냅니다.
+	// 상위 결과를 먼저 받아 처리하는 동안 나머지 결과를 계속 검색합니다.
+	// 서버에 reranker가 설정되어 있으면 Retrieve와 같이 순위를 다시 매긴 뒤 보내므로, 첫 결과는 전체 후보의 순위를 매긴 다음에 도착합니다.
+	RetrieveStream(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RetrieveStreamResponse], error)
 }
 
 type passageRetrievalServiceClient struct {
@@ -50,6 +55,25 @@ func (c *passageRetrievalServiceClient) Retrieve(ctx context.Context, in *Retrie
 	return out, nil
 }
 
+func (c *passageRetrievalServiceClient) RetrieveStream(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RetrieveStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PassageRetrievalService_ServiceDesc.Streams[0], PassageRetrievalService_RetrieveStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RetrieveRequest, RetrieveStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PassageRetrievalService_RetrieveStreamClient = grpc.ServerStreamingClient[RetrieveStreamResponse]
+
 // PassageRetrievalServiceServer is the server API for PassageRetrievalService service.
 // All implementations must embed UnimplementedPassageRetrievalServiceServer
 // for forward compatibility.
@@ -58,6 +82,10 @@ func (c *passageRetrievalServiceClient) Retrieve(ctx context.Context, in *Retrie
 type PassageRetrievalServiceServer interface {
 	// 패시지를 검색하고 찾은 결과를 반환합니다.
 	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error)
+	// 패시지를 검색하고 찾은 결과를 점수가 높은 순서로 하나씩 보냅니다.
+	// 상위 결과를 먼저 받아 처리하는 동안 나머지 결과를 계속 검색합니다.
+	// 서버에 reranker가 설정되어 있으면 Retrieve와 같이 순위를 다시 매긴 뒤 보내므로, 첫 결과는 전체 후보의 순위를 매긴 다음에 도착합니다.
+	RetrieveStream(*RetrieveRequest, grpc.ServerStreamingServer[RetrieveStreamResponse]) error
 	mustEmbedUnimplementedPassageRetrievalServiceServer()
 }
 
@@ -71,6 +99,9 @@ type UnimplementedPassageRetrievalServiceServer struct{}
 func (UnimplementedPassageRetrievalServiceServer) Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
 }
+func (UnimplementedPassageRetrievalServiceServer) RetrieveStream(*RetrieveRequest, grpc.ServerStreamingServer[RetrieveStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method RetrieveStream not implemented")
+}
 func (UnimplementedPassageRetrievalServiceServer) mustEmbedUnimplementedPassageRetrievalServiceServer() {
 }
 func (UnimplementedPassageRetrievalServiceServer) testEmbeddedByValue() {}
@@ -111,6 +142,17 @@ func _PassageRetrievalService_Retrieve_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PassageRetrievalService_RetrieveStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RetrieveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PassageRetrievalServiceServer).RetrieveStream(m, &grpc.GenericServerStream[RetrieveRequest, RetrieveStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PassageRetrievalService_RetrieveStreamServer = grpc.ServerStreamingServer[RetrieveStreamResponse]
+
 // PassageRetrievalService_ServiceDesc is the grpc.ServiceDesc for PassageRetrievalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +165,12 @@ var PassageRetrievalService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PassageRetrievalService_Retrieve_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RetrieveStream",
+			Handler:       _PassageRetrievalService_RetrieveStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "retrieval/passage/v1/service.proto",
 }
@@ -0,0 +1,1122 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: retrieval/passage/v2/service.proto
+
+package passage
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 패시지 검색 방식.
+type RetrievalMode int32
+
+const (
+	// 서비스 기본 방식을 사용합니다.
+	RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED RetrievalMode = 0
+	// 쿼리 임베딩과 가까운 패시지를 찾습니다.
+	RetrievalMode_RETRIEVAL_MODE_DENSE RetrievalMode = 1
+	// 쿼리의 단어와 일치하는 패시지를 희소 벡터로 찾습니다.
+	RetrievalMode_RETRIEVAL_MODE_SPARSE RetrievalMode = 2
+	// 두 방식의 결과를 Qdrant에서 합쳐 순위를 매깁니다.
+	RetrievalMode_RETRIEVAL_MODE_HYBRID RetrievalMode = 3
+)
+
+// Enum value maps for RetrievalMode.
+var (
+	RetrievalMode_name = map[int32]string{
+		0: "RETRIEVAL_MODE_UNSPECIFIED",
+		1: "RETRIEVAL_MODE_DENSE",
+		2: "RETRIEVAL_MODE_SPARSE",
+		3: "RETRIEVAL_MODE_HYBRID",
+	}
+	RetrievalMode_value = map[string]int32{
+		"RETRIEVAL_MODE_UNSPECIFIED": 0,
+		"RETRIEVAL_MODE_DENSE":       1,
+		"RETRIEVAL_MODE_SPARSE":      2,
+		"RETRIEVAL_MODE_HYBRID":      3,
+	}
+)
+
+func (x RetrievalMode) Enum() *RetrievalMode {
+	p := new(RetrievalMode)
+	*p = x
+	return p
+}
+
+func (x RetrievalMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetrievalMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_retrieval_passage_v2_service_proto_enumTypes[0].Descriptor()
+}
+
+func (RetrievalMode) Type() protoreflect.EnumType {
+	return &file_retrieval_passage_v2_service_proto_enumTypes[0]
+}
+
+func (x RetrievalMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetrievalMode.Descriptor instead.
+func (RetrievalMode) EnumDescriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{0}
+}
+
+// 패시지 검색 요청 메시지.
+type RetrieveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색 쿼리.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 검색 결과 수 제한.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 패시지 메타데이터 조건. 없으면 모든 패시지를 검색합니다.
+	Filter *Filter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 검색 방식. 지정하지 않으면 서비스 기본 방식을 사용합니다.
+	Mode          RetrievalMode `protobuf:"varint,4,opt,name=mode,proto3,enum=retrieval.passage.v2.RetrievalMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveRequest) Reset() {
+	*x = RetrieveRequest{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveRequest) ProtoMessage() {}
+
+func (x *RetrieveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveRequest) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RetrieveRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RetrieveRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RetrieveRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *RetrieveRequest) GetMode() RetrievalMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED
+}
+
+// 패시지 검색 응답 메시지.
+type RetrieveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색된 패시지 목록.
+	Passages      []*Passage `protobuf:"bytes,1,rep,name=passages,proto3" json:"passages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveResponse) Reset() {
+	*x = RetrieveResponse{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveResponse) ProtoMessage() {}
+
+func (x *RetrieveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveResponse.ProtoReflect.Descriptor instead.
+func (*RetrieveResponse) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetrieveResponse) GetPassages() []*Passage {
+	if x != nil {
+		return x.Passages
+	}
+	return nil
+}
+
+// 여러 쿼리 검색 요청 메시지.
+type BatchRetrieveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 쿼리별 검색 요청 목록.
+	Requests      []*RetrieveRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRetrieveRequest) Reset() {
+	*x = BatchRetrieveRequest{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRetrieveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRetrieveRequest) ProtoMessage() {}
+
+func (x *BatchRetrieveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRetrieveRequest.ProtoReflect.Descriptor instead.
+func (*BatchRetrieveRequest) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchRetrieveRequest) GetRequests() []*RetrieveRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// 여러 쿼리 검색 응답 메시지.
+type BatchRetrieveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 요청과 같은 순서의 쿼리별 검색 결과.
+	Results       []*BatchRetrieveResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRetrieveResponse) Reset() {
+	*x = BatchRetrieveResponse{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRetrieveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRetrieveResponse) ProtoMessage() {}
+
+func (x *BatchRetrieveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRetrieveResponse.ProtoReflect.Descriptor instead.
+func (*BatchRetrieveResponse) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchRetrieveResponse) GetResults() []*BatchRetrieveResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 쿼리 하나의 검색 결과.
+type BatchRetrieveResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 검색된 패시지 목록.
+	Passages []*Passage `protobuf:"bytes,1,rep,name=passages,proto3" json:"passages,omitempty"`
+	// 검색에 실패한 원인. 성공했으면 없습니다.
+	Error         *Error `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRetrieveResult) Reset() {
+	*x = BatchRetrieveResult{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRetrieveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRetrieveResult) ProtoMessage() {}
+
+func (x *BatchRetrieveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRetrieveResult.ProtoReflect.Descriptor instead.
+func (*BatchRetrieveResult) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchRetrieveResult) GetPassages() []*Passage {
+	if x != nil {
+		return x.Passages
+	}
+	return nil
+}
+
+func (x *BatchRetrieveResult) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// 쿼리 검색 오류.
+type Error struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// gRPC 상태 코드. (google.rpc.Code) e.g., 3 (INVALID_ARGUMENT)
+	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// 오류 메시지.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 패시지 정보.
+type Passage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 패시지 ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 패시지 스코어.
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	// 패시지가 속한 문서 키. e.g., "AA-123", "confluence:123456"
+	DocumentKey string `protobuf:"bytes,3,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	// 문서 제목. 저장되지 않았으면 비어 있습니다.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// 패시지 내용.
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// 문서 안에서의 패시지 순서. 0부터 시작합니다.
+	ChunkIndex int32 `protobuf:"varint,6,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	// 문서 웹 주소. 저장되지 않았으면 비어 있습니다.
+	SourceUrl string `protobuf:"bytes,7,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// 위 필드에 포함되지 않은 나머지 메타데이터. e.g., "project", "status", "labels"
+	Metadata *structpb.Struct `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// reranker가 계산한 쿼리와의 관련도 점수. 다시 순위를 매기지 않았으면 없습니다.
+	// 있으면 패시지는 이 점수 순서로 정렬되며, score는 다시 순위를 매기기 전의 검색 점수입니다.
+	RerankScore   *wrapperspb.FloatValue `protobuf:"bytes,9,opt,name=rerank_score,json=rerankScore,proto3" json:"rerank_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Passage) Reset() {
+	*x = Passage{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passage) ProtoMessage() {}
+
+func (x *Passage) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passage.ProtoReflect.Descriptor instead.
+func (*Passage) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Passage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Passage) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Passage) GetDocumentKey() string {
+	if x != nil {
+		return x.DocumentKey
+	}
+	return ""
+}
+
+func (x *Passage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Passage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Passage) GetChunkIndex() int32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *Passage) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *Passage) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Passage) GetRerankScore() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.RerankScore
+	}
+	return nil
+}
+
+// 패시지 메타데이터 조건.
+// must 조건은 모두, should 조건은 하나 이상 만족해야 하며, must_not 조건은 하나도 만족하면 안 됩니다.
+type Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 모두 만족해야 하는 조건.
+	Must []*Condition `protobuf:"bytes,1,rep,name=must,proto3" json:"must,omitempty"`
+	// 하나 이상 만족해야 하는 조건.
+	Should []*Condition `protobuf:"bytes,2,rep,name=should,proto3" json:"should,omitempty"`
+	// 만족하면 안 되는 조건.
+	MustNot       []*Condition `protobuf:"bytes,3,rep,name=must_not,json=mustNot,proto3" json:"must_not,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Filter) GetMust() []*Condition {
+	if x != nil {
+		return x.Must
+	}
+	return nil
+}
+
+func (x *Filter) GetShould() []*Condition {
+	if x != nil {
+		return x.Should
+	}
+	return nil
+}
+
+func (x *Filter) GetMustNot() []*Condition {
+	if x != nil {
+		return x.MustNot
+	}
+	return nil
+}
+
+// 메타데이터 필드 하나에 대한 조건.
+type Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 메타데이터 필드 이름. e.g., "key", "project", "status", "labels", "updated"
+	// 값이 목록인 필드(labels)는 값 중 하나라도 조건을 만족하면 됩니다.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Types that are valid to be assigned to Condition:
+	//
+	//	*Condition_Keyword
+	//	*Condition_Keywords
+	//	*Condition_Integer
+	//	*Condition_Boolean
+	//	*Condition_Range
+	//	*Condition_DatetimeRange
+	//	*Condition_Filter
+	Condition     isCondition_Condition `protobuf_oneof:"condition"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Condition) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Condition) GetCondition() isCondition_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *Condition) GetKeyword() string {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Keyword); ok {
+			return x.Keyword
+		}
+	}
+	return ""
+}
+
+func (x *Condition) GetKeywords() *Keywords {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Keywords); ok {
+			return x.Keywords
+		}
+	}
+	return nil
+}
+
+func (x *Condition) GetInteger() int64 {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Integer); ok {
+			return x.Integer
+		}
+	}
+	return 0
+}
+
+func (x *Condition) GetBoolean() bool {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Boolean); ok {
+			return x.Boolean
+		}
+	}
+	return false
+}
+
+func (x *Condition) GetRange() *Range {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Range); ok {
+			return x.Range
+		}
+	}
+	return nil
+}
+
+func (x *Condition) GetDatetimeRange() *DatetimeRange {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_DatetimeRange); ok {
+			return x.DatetimeRange
+		}
+	}
+	return nil
+}
+
+func (x *Condition) GetFilter() *Filter {
+	if x != nil {
+		if x, ok := x.Condition.(*Condition_Filter); ok {
+			return x.Filter
+		}
+	}
+	return nil
+}
+
+type isCondition_Condition interface {
+	isCondition_Condition()
+}
+
+type Condition_Keyword struct {
+	// 값이 일치하는 패시지.
+	Keyword string `protobuf:"bytes,2,opt,name=keyword,proto3,oneof"`
+}
+
+type Condition_Keywords struct {
+	// 값이 목록 중 하나와 일치하는 패시지.
+	Keywords *Keywords `protobuf:"bytes,3,opt,name=keywords,proto3,oneof"`
+}
+
+type Condition_Integer struct {
+	// 정수 값이 일치하는 패시지.
+	Integer int64 `protobuf:"varint,4,opt,name=integer,proto3,oneof"`
+}
+
+type Condition_Boolean struct {
+	// 불리언 값이 일치하는 패시지.
+	Boolean bool `protobuf:"varint,5,opt,name=boolean,proto3,oneof"`
+}
+
+type Condition_Range struct {
+	// 숫자 값이 범위 안에 있는 패시지.
+	Range *Range `protobuf:"bytes,6,opt,name=range,proto3,oneof"`
+}
+
+type Condition_DatetimeRange struct {
+	// 날짜 값(RFC 3339)이 범위 안에 있는 패시지.
+	DatetimeRange *DatetimeRange `protobuf:"bytes,7,opt,name=datetime_range,json=datetimeRange,proto3,oneof"`
+}
+
+type Condition_Filter struct {
+	// 하위 조건. field는 사용하지 않습니다.
+	Filter *Filter `protobuf:"bytes,8,opt,name=filter,proto3,oneof"`
+}
+
+func (*Condition_Keyword) isCondition_Condition() {}
+
+func (*Condition_Keywords) isCondition_Condition() {}
+
+func (*Condition_Integer) isCondition_Condition() {}
+
+func (*Condition_Boolean) isCondition_Condition() {}
+
+func (*Condition_Range) isCondition_Condition() {}
+
+func (*Condition_DatetimeRange) isCondition_Condition() {}
+
+func (*Condition_Filter) isCondition_Condition() {}
+
+// 키워드 목록.
+type Keywords struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Keywords) Reset() {
+	*x = Keywords{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Keywords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keywords) ProtoMessage() {}
+
+func (x *Keywords) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keywords.ProtoReflect.Descriptor instead.
+func (*Keywords) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Keywords) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 숫자 범위. 설정하지 않은 경계는 제한하지 않습니다.
+type Range struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Gt            *wrapperspb.DoubleValue `protobuf:"bytes,1,opt,name=gt,proto3" json:"gt,omitempty"`
+	Gte           *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lt            *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=lt,proto3" json:"lt,omitempty"`
+	Lte           *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Range) GetGt() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *Range) GetGte() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *Range) GetLt() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *Range) GetLte() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+// 날짜 범위. 설정하지 않은 경계는 제한하지 않습니다.
+type DatetimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=gt,proto3" json:"gt,omitempty"`
+	Gte           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lt,proto3" json:"lt,omitempty"`
+	Lte           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatetimeRange) Reset() {
+	*x = DatetimeRange{}
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatetimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatetimeRange) ProtoMessage() {}
+
+func (x *DatetimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_retrieval_passage_v2_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatetimeRange.ProtoReflect.Descriptor instead.
+func (*DatetimeRange) Descriptor() ([]byte, []int) {
+	return file_retrieval_passage_v2_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DatetimeRange) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *DatetimeRange) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *DatetimeRange) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *DatetimeRange) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+var File_retrieval_passage_v2_service_proto protoreflect.FileDescriptor
+
+const file_retrieval_passage_v2_service_proto_rawDesc = "" +
+	"\n" +
+	"\"retrieval/passage/v2/service.proto\x12\x14retrieval.passage.v2\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xac\x01\n" +
+	"\x0fRetrieveRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.retrieval.passage.v2.FilterR\x06filter\x127\n" +
+	"\x04mode\x18\x04 \x01(\x0e2#.retrieval.passage.v2.RetrievalModeR\x04mode\"M\n" +
+	"\x10RetrieveResponse\x129\n" +
+	"\bpassages\x18\x01 \x03(\v2\x1d.retrieval.passage.v2.PassageR\bpassages\"Y\n" +
+	"\x14BatchRetrieveRequest\x12A\n" +
+	"\brequests\x18\x01 \x03(\v2%.retrieval.passage.v2.RetrieveRequestR\brequests\"\\\n" +
+	"\x15BatchRetrieveResponse\x12C\n" +
+	"\aresults\x18\x01 \x03(\v2).retrieval.passage.v2.BatchRetrieveResultR\aresults\"\x83\x01\n" +
+	"\x13BatchRetrieveResult\x129\n" +
+	"\bpassages\x18\x01 \x03(\v2\x1d.retrieval.passage.v2.PassageR\bpassages\x121\n" +
+	"\x05error\x18\x02 \x01(\v2\x1b.retrieval.passage.v2.ErrorR\x05error\"5\n" +
+	"\x05Error\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb1\x02\n" +
+	"\aPassage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\x12!\n" +
+	"\fdocument_key\x18\x03 \x01(\tR\vdocumentKey\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x1f\n" +
+	"\vchunk_index\x18\x06 \x01(\x05R\n" +
+	"chunkIndex\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\a \x01(\tR\tsourceUrl\x123\n" +
+	"\bmetadata\x18\b \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12>\n" +
+	"\frerank_score\x18\t \x01(\v2\x1b.google.protobuf.FloatValueR\vrerankScore\"\xb2\x01\n" +
+	"\x06Filter\x123\n" +
+	"\x04must\x18\x01 \x03(\v2\x1f.retrieval.passage.v2.ConditionR\x04must\x127\n" +
+	"\x06should\x18\x02 \x03(\v2\x1f.retrieval.passage.v2.ConditionR\x06should\x12:\n" +
+	"\bmust_not\x18\x03 \x03(\v2\x1f.retrieval.passage.v2.ConditionR\amustNot\"\xfb\x02\n" +
+	"\tCondition\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\akeyword\x18\x02 \x01(\tH\x00R\akeyword\x12<\n" +
+	"\bkeywords\x18\x03 \x01(\v2\x1e.retrieval.passage.v2.KeywordsH\x00R\bkeywords\x12\x1a\n" +
+	"\ainteger\x18\x04 \x01(\x03H\x00R\ainteger\x12\x1a\n" +
+	"\aboolean\x18\x05 \x01(\bH\x00R\aboolean\x123\n" +
+	"\x05range\x18\x06 \x01(\v2\x1b.retrieval.passage.v2.RangeH\x00R\x05range\x12L\n" +
+	"\x0edatetime_range\x18\a \x01(\v2#.retrieval.passage.v2.DatetimeRangeH\x00R\rdatetimeRange\x126\n" +
+	"\x06filter\x18\b \x01(\v2\x1c.retrieval.passage.v2.FilterH\x00R\x06filterB\v\n" +
+	"\tcondition\"\"\n" +
+	"\bKeywords\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xc3\x01\n" +
+	"\x05Range\x12,\n" +
+	"\x02gt\x18\x01 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02gt\x12.\n" +
+	"\x03gte\x18\x02 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03gte\x12,\n" +
+	"\x02lt\x18\x03 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02lt\x12.\n" +
+	"\x03lte\x18\x04 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03lte\"\xc3\x01\n" +
+	"\rDatetimeRange\x12*\n" +
+	"\x02gt\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02gt\x12,\n" +
+	"\x03gte\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03gte\x12*\n" +
+	"\x02lt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02lt\x12,\n" +
+	"\x03lte\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03lte*\x7f\n" +
+	"\rRetrievalMode\x12\x1e\n" +
+	"\x1aRETRIEVAL_MODE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14RETRIEVAL_MODE_DENSE\x10\x01\x12\x19\n" +
+	"\x15RETRIEVAL_MODE_SPARSE\x10\x02\x12\x19\n" +
+	"\x15RETRIEVAL_MODE_HYBRID\x10\x032\xde\x01\n" +
+	"\x17PassageRetrievalService\x12Y\n" +
+	"\bRetrieve\x12%.retrieval.passage.v2.RetrieveRequest\x1a&.retrieval.passage.v2.RetrieveResponse\x12h\n" +
+	"\rBatchRetrieve\x12*.retrieval.passage.v2.BatchRetrieveRequest\x1a+.retrieval.passage.v2.BatchRetrieveResponseBJZHgithub.com/devafterdark/project-lumos/proto/retrieval/passage/v2;passageb\x06proto3"
+
+var (
+	file_retrieval_passage_v2_service_proto_rawDescOnce sync.Once
+	file_retrieval_passage_v2_service_proto_rawDescData []byte
+)
+
+func file_retrieval_passage_v2_service_proto_rawDescGZIP() []byte {
+	file_retrieval_passage_v2_service_proto_rawDescOnce.Do(func() {
+		file_retrieval_passage_v2_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_retrieval_passage_v2_service_proto_rawDesc), len(file_retrieval_passage_v2_service_proto_rawDesc)))
+	})
+	return file_retrieval_passage_v2_service_proto_rawDescData
+}
+
+var file_retrieval_passage_v2_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_retrieval_passage_v2_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_retrieval_passage_v2_service_proto_goTypes = []any{
+	(RetrievalMode)(0),             // 0: retrieval.passage.v2.RetrievalMode
+	(*RetrieveRequest)(nil),        // 1: retrieval.passage.v2.RetrieveRequest
+	(*RetrieveResponse)(nil),       // 2: retrieval.passage.v2.RetrieveResponse
+	(*BatchRetrieveRequest)(nil),   // 3: retrieval.passage.v2.BatchRetrieveRequest
+	(*BatchRetrieveResponse)(nil),  // 4: retrieval.passage.v2.BatchRetrieveResponse
+	(*BatchRetrieveResult)(nil),    // 5: retrieval.passage.v2.BatchRetrieveResult
+	(*Error)(nil),                  // 6: retrieval.passage.v2.Error
+	(*Passage)(nil),                // 7: retrieval.passage.v2.Passage
+	(*Filter)(nil),                 // 8: retrieval.passage.v2.Filter
+	(*Condition)(nil),              // 9: retrieval.passage.v2.Condition
+	(*Keywords)(nil),               // 10: retrieval.passage.v2.Keywords
+	(*Range)(nil),                  // 11: retrieval.passage.v2.Range
+	(*DatetimeRange)(nil),          // 12: retrieval.passage.v2.DatetimeRange
+	(*structpb.Struct)(nil),        // 13: google.protobuf.Struct
+	(*wrapperspb.FloatValue)(nil),  // 14: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil), // 15: google.protobuf.DoubleValue
+	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
+}
+var file_retrieval_passage_v2_service_proto_depIdxs = []int32{
+	8,  // 0: retrieval.passage.v2.RetrieveRequest.filter:type_name -> retrieval.passage.v2.Filter
+	0,  // 1: retrieval.passage.v2.RetrieveRequest.mode:type_name -> retrieval.passage.v2.RetrievalMode
+	7,  // 2: retrieval.passage.v2.RetrieveResponse.passages:type_name -> retrieval.passage.v2.Passage
+	1,  // 3: retrieval.passage.v2.BatchRetrieveRequest.requests:type_name -> retrieval.passage.v2.RetrieveRequest
+	5,  // 4: retrieval.passage.v2.BatchRetrieveResponse.results:type_name -> retrieval.passage.v2.BatchRetrieveResult
+	7,  // 5: retrieval.passage.v2.BatchRetrieveResult.passages:type_name -> retrieval.passage.v2.Passage
+	6,  // 6: retrieval.passage.v2.BatchRetrieveResult.error:type_name -> retrieval.passage.v2.Error
+	13, // 7: retrieval.passage.v2.Passage.metadata:type_name -> google.protobuf.Struct
+	14, // 8: retrieval.passage.v2.Passage.rerank_score:type_name -> google.protobuf.FloatValue
+	9,  // 9: retrieval.passage.v2.Filter.must:type_name -> retrieval.passage.v2.Condition
+	9,  // 10: retrieval.passage.v2.Filter.should:type_name -> retrieval.passage.v2.Condition
+	9,  // 11: retrieval.passage.v2.Filter.must_not:type_name -> retrieval.passage.v2.Condition
+	10, // 12: retrieval.passage.v2.Condition.keywords:type_name -> retrieval.passage.v2.Keywords
+	11, // 13: retrieval.passage.v2.Condition.range:type_name -> retrieval.passage.v2.Range
+	12, // 14: retrieval.passage.v2.Condition.datetime_range:type_name -> retrieval.passage.v2.DatetimeRange
+	8,  // 15: retrieval.passage.v2.Condition.filter:type_name -> retrieval.passage.v2.Filter
+	15, // 16: retrieval.passage.v2.Range.gt:type_name -> google.protobuf.DoubleValue
+	15, // 17: retrieval.passage.v2.Range.gte:type_name -> google.protobuf.DoubleValue
+	15, // 18: retrieval.passage.v2.Range.lt:type_name -> google.protobuf.DoubleValue
+	15, // 19: retrieval.passage.v2.Range.lte:type_name -> google.protobuf.DoubleValue
+	16, // 20: retrieval.passage.v2.DatetimeRange.gt:type_name -> google.protobuf.Timestamp
+	16, // 21: retrieval.passage.v2.DatetimeRange.gte:type_name -> google.protobuf.Timestamp
+	16, // 22: retrieval.passage.v2.DatetimeRange.lt:type_name -> google.protobuf.Timestamp
+	16, // 23: retrieval.passage.v2.DatetimeRange.lte:type_name -> google.protobuf.Timestamp
+	1,  // 24: retrieval.passage.v2.PassageRetrievalService.Retrieve:input_type -> retrieval.passage.v2.RetrieveRequest
+	3,  // 25: retrieval.passage.v2.PassageRetrievalService.BatchRetrieve:input_type -> retrieval.passage.v2.BatchRetrieveRequest
+	2,  // 26: retrieval.passage.v2.PassageRetrievalService.Retrieve:output_type -> retrieval.passage.v2.RetrieveResponse
+	4,  // 27: retrieval.passage.v2.PassageRetrievalService.BatchRetrieve:output_type -> retrieval.passage.v2.BatchRetrieveResponse
+	26, // [26:28] is the sub-list for method output_type
+	24, // [24:26] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_retrieval_passage_v2_service_proto_init() }
+func file_retrieval_passage_v2_service_proto_init() {
+	if File_retrieval_passage_v2_service_proto != nil {
+		return
+	}
+	file_retrieval_passage_v2_service_proto_msgTypes[8].OneofWrappers = []any{
+		(*Condition_Keyword)(nil),
+		(*Condition_Keywords)(nil),
+		(*Condition_Integer)(nil),
+		(*Condition_Boolean)(nil),
+		(*Condition_Range)(nil),
+		(*Condition_DatetimeRange)(nil),
+		(*Condition_Filter)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_retrieval_passage_v2_service_proto_rawDesc), len(file_retrieval_passage_v2_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_retrieval_passage_v2_service_proto_goTypes,
+		DependencyIndexes: file_retrieval_passage_v2_service_proto_depIdxs,
+		EnumInfos:         file_retrieval_passage_v2_service_proto_enumTypes,
+		MessageInfos:      file_retrieval_passage_v2_service_proto_msgTypes,
+	}.Build()
+	File_retrieval_passage_v2_service_proto = out.File
+	file_retrieval_passage_v2_service_proto_goTypes = nil
+	file_retrieval_passage_v2_service_proto_depIdxs = nil
+}
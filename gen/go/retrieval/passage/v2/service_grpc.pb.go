@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: retrieval/passage/v2/service.proto
+
+package passage
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PassageRetrievalService_Retrieve_FullMethodName      = "/retrieval.passage.v2.PassageRetrievalService/Retrieve"
+	PassageRetrievalService_BatchRetrieve_FullMethodName = "/retrieval.passage.v2.PassageRetrievalService/BatchRetrieve"
+)
+
+// PassageRetrievalServiceClient is the client API for PassageRetrievalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 패시지 검색 서비스.
+type PassageRetrievalServiceClient interface {
+	// 패시지를 검색하고 찾은 결과를 반환합니다.
+	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error)
+	// 여러 쿼리의 패시지를 한 번에 검색합니다.
+	// 쿼리 하나가 실패해도 나머지 쿼리의 결과는 반환하며, 실패한 쿼리는 결과의 error에 원인을 담습니다.
+	BatchRetrieve(ctx context.Context, in *BatchRetrieveRequest, opts ...grpc.CallOption) (*BatchRetrieveResponse, error)
+}
+
+type passageRetrievalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPassageRetrievalServiceClient(cc grpc.ClientConnInterface) PassageRetrievalServiceClient {
+	return &passageRetrievalServiceClient{cc}
+}
+
+func (c *passageRetrievalServiceClient) Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetrieveResponse)
+	err := c.cc.Invoke(ctx, PassageRetrievalService_Retrieve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passageRetrievalServiceClient) BatchRetrieve(ctx context.Context, in *BatchRetrieveRequest, opts ...grpc.CallOption) (*BatchRetrieveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchRetrieveResponse)
+	err := c.cc.Invoke(ctx, PassageRetrievalService_BatchRetrieve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PassageRetrievalServiceServer is the server API for PassageRetrievalService service.
+// All implementations must embed UnimplementedPassageRetrievalServiceServer
+// for forward compatibility.
+//
+// 패시지 검색 서비스.
+type PassageRetrievalServiceServer interface {
+	// 패시지를 검색하고 찾은 결과를 반환합니다.
+	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error)
+	// 여러 쿼리의 패시지를 한 번에 검색합니다.
+	// 쿼리 하나가 실패해도 나머지 쿼리의 결과는 반환하며, 실패한 쿼리는 결과의 error에 원인을 담습니다.
+	BatchRetrieve(context.Context, *BatchRetrieveRequest) (*BatchRetrieveResponse, error)
+	mustEmbedUnimplementedPassageRetrievalServiceServer()
+}
+
+// UnimplementedPassageRetrievalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPassageRetrievalServiceServer struct{}
+
+func (UnimplementedPassageRetrievalServiceServer) Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
+}
+func (UnimplementedPassageRetrievalServiceServer) BatchRetrieve(context.Context, *BatchRetrieveRequest) (*BatchRetrieveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRetrieve not implemented")
+}
+func (UnimplementedPassageRetrievalServiceServer) mustEmbedUnimplementedPassageRetrievalServiceServer() {
+}
+func (UnimplementedPassageRetrievalServiceServer) testEmbeddedByValue() {}
+
+// UnsafePassageRetrievalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PassageRetrievalServiceServer will
+// result in compilation errors.
+type UnsafePassageRetrievalServiceServer interface {
+	mustEmbedUnimplementedPassageRetrievalServiceServer()
+}
+
+func RegisterPassageRetrievalServiceServer(s grpc.ServiceRegistrar, srv PassageRetrievalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPassageRetrievalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PassageRetrievalService_ServiceDesc, srv)
+}
+
+func _PassageRetrievalService_Retrieve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassageRetrievalServiceServer).Retrieve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassageRetrievalService_Retrieve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassageRetrievalServiceServer).Retrieve(ctx, req.(*RetrieveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassageRetrievalService_BatchRetrieve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRetrieveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassageRetrievalServiceServer).BatchRetrieve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassageRetrievalService_BatchRetrieve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassageRetrievalServiceServer).BatchRetrieve(ctx, req.(*BatchRetrieveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PassageRetrievalService_ServiceDesc is the grpc.ServiceDesc for PassageRetrievalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PassageRetrievalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "retrieval.passage.v2.PassageRetrievalService",
+	HandlerType: (*PassageRetrievalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Retrieve",
+			Handler:    _PassageRetrievalService_Retrieve_Handler,
+		},
+		{
+			MethodName: "BatchRetrieve",
+			Handler:    _PassageRetrievalService_BatchRetrieve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "retrieval/passage/v2/service.proto",
+}
@@ -22,9 +22,10 @@ _runtime_version.ValidateProtobufRuntimeVersion(
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n retrieval/issue/v1/service.proto\x12\x12retrieval.issue.v1\"%\n\x0fRetrieveRequest\x12\x12\n\nissue_keys\x18\x01 \x03(\t\"=\n\x10RetrieveResponse\x12)\n\x06issues\x18\x01 \x03(\x0b\x32\x19.retrieval.issue.v1.Issue\"F\n\x05Issue\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05title\x18\x02 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12\x10\n\x08\x63omments\x18\x04 \x03(\t2n\n\x15IssueRetrievalService\x12U\n\x08Retrieve\x12#.retrieval.issue.v1.RetrieveRequest\x1a$.retrieval.issue.v1.RetrieveResponseBFZDgithub.com/devafterdark/project-lumos/proto/retrieval/issue/v1;issueb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n retrieval/issue/v1/service.proto\x12\x12retrieval.issue.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"%\n\x0fRetrieveRequest\x12\x12\n\nissue_keys\x18\x01 \x03(\t\"=\n\x10RetrieveResponse\x12)\n\x06issues\x18\x01 \x03(\x0b\x32\x19.retrieval.issue.v1.Issue\"v\n\rSearchRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12/\n\x06\x66ilter\x18\x02 \x01(\x0b\x32\x1f.retrieval.issue.v1.IssueFilter\x12\x11\n\tpage_size\x18\x03 \x01(\x05\x12\x12\n\npage_token\x18\x04 \x01(\t\"h\n\x0eSearchResponse\x12)\n\x06issues\x18\x01 \x03(\x0b\x32\x19.retrieval.issue.v1.Issue\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\x12\x12\n\ntotal_size\x18\x03 \x01(\x05\"e\n\x0bListRequest\x12/\n\x06\x66ilter\x18\x01 \x01(\x0b\x32\x1f.retrieval.issue.v1.IssueFilter\x12\x11\n\tpage_size\x18\x02 \x01(\x05\x12\x12\n\npage_token\x18\x03 \x01(\t\"f\n\x0cListResponse\x12)\n\x06issues\x18\x01 \x03(\x0b\x32\x19.retrieval.issue.v1.Issue\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\x12\x12\n\ntotal_size\x18\x03 \x01(\x05\"\xb4\x01\n\x0bIssueFilter\x12\x10\n\x08projects\x18\x01 \x03(\t\x12\x10\n\x08statuses\x18\x02 \x03(\t\x12\x0e\n\x06labels\x18\x03 \x03(\t\x12\x11\n\tassignees\x18\x04 \x03(\t\x12.\n\x07\x63reated\x18\x05 \x01(\x0b\x32\x1d.retrieval.issue.v1.TimeRange\x12.\n\x07updated\x18\x06 \x01(\x0b\x32\x1d.retrieval.issue.v1.TimeRange\"_\n\tTimeRange\x12)\n\x05start\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x03\x65nd\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xdf\x01\n\x05Issue\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05title\x18\x02 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12\x10\n\x08\x63omments\x18\x04 \x03(\t\x12\x0e\n\x06status\x18\x05 \x01(\t\x12\x0e\n\x06labels\x18\x06 \x03(\t\x12\x10\n\x08\x61ssignee\x18\x07 \x01(\t\x12\x0b\n\x03url\x18\x08 \x01(\t\x12+\n\x07\x63reated\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07updated\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp2\x8a\x02\n\x15IssueRetrievalService\x12U\n\x08Retrieve\x12#.retrieval.issue.v1.RetrieveRequest\x1a$.retrieval.issue.v1.RetrieveResponse\x12O\n\x06Search\x12!.retrieval.issue.v1.SearchRequest\x1a\".retrieval.issue.v1.SearchResponse\x12I\n\x04List\x12\x1f.retrieval.issue.v1.ListRequest\x1a .retrieval.issue.v1.ListResponseBFZDgithub.com/devafterdark/project-lumos/proto/retrieval/issue/v1;issueb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,12 +33,24 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'retrieval.issue.v1.service_
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'ZDgithub.com/devafterdark/project-lumos/proto/retrieval/issue/v1;issue'
-  _globals['_RETRIEVEREQUEST']._serialized_start=56
-  _globals['_RETRIEVEREQUEST']._serialized_end=93
-  _globals['_RETRIEVERESPONSE']._serialized_start=95
-  _globals['_RETRIEVERESPONSE']._serialized_end=156
-  _globals['_ISSUE']._serialized_start=158
-  _globals['_ISSUE']._serialized_end=228
-  _globals['_ISSUERETRIEVALSERVICE']._serialized_start=230
-  _globals['_ISSUERETRIEVALSERVICE']._serialized_end=340
+  _globals['_RETRIEVEREQUEST']._serialized_start=89
+  _globals['_RETRIEVEREQUEST']._serialized_end=126
+  _globals['_RETRIEVERESPONSE']._serialized_start=128
+  _globals['_RETRIEVERESPONSE']._serialized_end=189
+  _globals['_SEARCHREQUEST']._serialized_start=191
+  _globals['_SEARCHREQUEST']._serialized_end=309
+  _globals['_SEARCHRESPONSE']._serialized_start=311
+  _globals['_SEARCHRESPONSE']._serialized_end=415
+  _globals['_LISTREQUEST']._serialized_start=417
+  _globals['_LISTREQUEST']._serialized_end=518
+  _globals['_LISTRESPONSE']._serialized_start=520
+  _globals['_LISTRESPONSE']._serialized_end=622
+  _globals['_ISSUEFILTER']._serialized_start=625
+  _globals['_ISSUEFILTER']._serialized_end=805
+  _globals['_TIMERANGE']._serialized_start=807
+  _globals['_TIMERANGE']._serialized_end=902
+  _globals['_ISSUE']._serialized_start=905
+  _globals['_ISSUE']._serialized_end=1128
+  _globals['_ISSUERETRIEVALSERVICE']._serialized_start=1131
+  _globals['_ISSUERETRIEVALSERVICE']._serialized_end=1397
 # @@protoc_insertion_point(module_scope)
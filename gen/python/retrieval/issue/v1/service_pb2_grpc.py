@@ -40,6 +40,16 @@ class IssueRetrievalServiceStub(object):
                 request_serializer=retrieval_dot_issue_dot_v1_dot_service__pb2.RetrieveRequest.SerializeToString,
                 response_deserializer=retrieval_dot_issue_dot_v1_dot_service__pb2.RetrieveResponse.FromString,
                 _registered_method=True)
+        self.Search = channel.unary_unary(
+                '/retrieval.issue.v1.IssueRetrievalService/Search',
+                request_serializer=retrieval_dot_issue_dot_v1_dot_service__pb2.SearchRequest.SerializeToString,
+                response_deserializer=retrieval_dot_issue_dot_v1_dot_service__pb2.SearchResponse.FromString,
+                _registered_method=True)
+        self.List = channel.unary_unary(
+                '/retrieval.issue.v1.IssueRetrievalService/List',
+                request_serializer=retrieval_dot_issue_dot_v1_dot_service__pb2.ListRequest.SerializeToString,
+                response_deserializer=retrieval_dot_issue_dot_v1_dot_service__pb2.ListResponse.FromString,
+                _registered_method=True)
 
 
 class IssueRetrievalServiceServicer(object):
@@ -53,6 +63,20 @@ class IssueRetrievalServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Search(self, request, context):
+        """검색어가 포함된 이슈를 관련도 순서로 반환합니다.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def List(self, request, context):
+        """조건에 맞는 이슈를 최근 수정한 순서로 반환합니다.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_IssueRetrievalServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -61,6 +85,16 @@ def add_IssueRetrievalServiceServicer_to_server(servicer, server):
                     request_deserializer=retrieval_dot_issue_dot_v1_dot_service__pb2.RetrieveRequest.FromString,
                     response_serializer=retrieval_dot_issue_dot_v1_dot_service__pb2.RetrieveResponse.SerializeToString,
             ),
+            'Search': grpc.unary_unary_rpc_method_handler(
+                    servicer.Search,
+                    request_deserializer=retrieval_dot_issue_dot_v1_dot_service__pb2.SearchRequest.FromString,
+                    response_serializer=retrieval_dot_issue_dot_v1_dot_service__pb2.SearchResponse.SerializeToString,
+            ),
+            'List': grpc.unary_unary_rpc_method_handler(
+                    servicer.List,
+                    request_deserializer=retrieval_dot_issue_dot_v1_dot_service__pb2.ListRequest.FromString,
+                    response_serializer=retrieval_dot_issue_dot_v1_dot_service__pb2.ListResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'retrieval.issue.v1.IssueRetrievalService', rpc_method_handlers)
@@ -99,3 +133,57 @@ class IssueRetrievalService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def Search(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/retrieval.issue.v1.IssueRetrievalService/Search',
+            retrieval_dot_issue_dot_v1_dot_service__pb2.SearchRequest.SerializeToString,
+            retrieval_dot_issue_dot_v1_dot_service__pb2.SearchResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def List(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/retrieval.issue.v1.IssueRetrievalService/List',
+            retrieval_dot_issue_dot_v1_dot_service__pb2.ListRequest.SerializeToString,
+            retrieval_dot_issue_dot_v1_dot_service__pb2.ListResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -22,9 +22,11 @@ _runtime_version.ValidateProtobufRuntimeVersion(
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+from google.protobuf import wrappers_pb2 as google_dot_protobuf_dot_wrappers__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\"retrieval/passage/v1/service.proto\x12\x14retrieval.passage.v1\"/\n\x0fRetrieveRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\r\n\x05limit\x18\x02 \x01(\x05\"C\n\x10RetrieveResponse\x12/\n\x08passages\x18\x01 \x03(\x0b\x32\x1d.retrieval.passage.v1.Passage\")\n\x07Passage\x12\r\n\x05score\x18\x01 \x01(\x02\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\x0c\x32t\n\x17PassageRetrievalService\x12Y\n\x08Retrieve\x12%.retrieval.passage.v1.RetrieveRequest\x1a&.retrieval.passage.v1.RetrieveResponseBJZHgithub.com/devafterdark/project-lumos/proto/retrieval/passage/v1;passageb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\"retrieval/passage/v1/service.proto\x12\x14retrieval.passage.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"]\n\x0fRetrieveRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\r\n\x05limit\x18\x02 \x01(\x05\x12,\n\x06\x66ilter\x18\x03 \x01(\x0b\x32\x1c.retrieval.passage.v1.Filter\"C\n\x10RetrieveResponse\x12/\n\x08passages\x18\x01 \x03(\x0b\x32\x1d.retrieval.passage.v1.Passage\"H\n\x16RetrieveStreamResponse\x12.\n\x07passage\x18\x01 \x01(\x0b\x32\x1d.retrieval.passage.v1.Passage\")\n\x07Passage\x12\r\n\x05score\x18\x01 \x01(\x02\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\x0c\"\x9b\x01\n\x06\x46ilter\x12-\n\x04must\x18\x01 \x03(\x0b\x32\x1f.retrieval.passage.v1.Condition\x12/\n\x06should\x18\x02 \x03(\x0b\x32\x1f.retrieval.passage.v1.Condition\x12\x31\n\x08must_not\x18\x03 \x03(\x0b\x32\x1f.retrieval.passage.v1.Condition\"\xb1\x02\n\tCondition\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12\x11\n\x07keyword\x18\x02 \x01(\tH\x00\x12\x32\n\x08keywords\x18\x03 \x01(\x0b\x32\x1e.retrieval.passage.v1.KeywordsH\x00\x12\x11\n\x07integer\x18\x04 \x01(\x03H\x00\x12\x11\n\x07\x62oolean\x18\x05 \x01(\x08H\x00\x12,\n\x05range\x18\x06 \x01(\x0b\x32\x1b.retrieval.passage.v1.RangeH\x00\x12=\n\x0e\x64\x61tetime_range\x18\x07 \x01(\x0b\x32#.retrieval.passage.v1.DatetimeRangeH\x00\x12.\n\x06\x66ilter\x18\x08 \x01(\x0b\x32\x1c.retrieval.passage.v1.FilterH\x00\x42\x0b\n\tcondition\"\x1a\n\x08Keywords\x12\x0e\n\x06values\x18\x01 \x03(\t\"\xb1\x01\n\x05Range\x12(\n\x02gt\x18\x01 \x01(\x0b\x32\x1c.google.protobuf.DoubleValue\x12)\n\x03gte\x18\x02 \x01(\x0b\x32\x1c.google.protobuf.DoubleValue\x12(\n\x02lt\x18\x03 \x01(\x0b\x32\x1c.google.protobuf.DoubleValue\x12)\n\x03lte\x18\x04 \x01(\x0b\x32\x1c.google.protobuf.DoubleValue\"\xb1\x01\n\rDatetimeRange\x12&\n\x02gt\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x03gte\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12&\n\x02lt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x03lte\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp2\xdd\x01\n\x17PassageRetrievalService\x12Y\n\x08Retrieve\x12%.retrieval.passage.v1.RetrieveRequest\x1a&.retrieval.passage.v1.RetrieveResponse\x12g\n\x0eRetrieveStream\x12%.retrieval.passage.v1.RetrieveRequest\x1a,.retrieval.passage.v1.RetrieveStreamResponse0\x01\x42JZHgithub.com/devafterdark/project-lumos/proto/retrieval/passage/v1;passageb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,12 +34,24 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'retrieval.passage.v1.servic
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'ZHgithub.com/devafterdark/project-lumos/proto/retrieval/passage/v1;passage'
-  _globals['_RETRIEVEREQUEST']._serialized_start=60
-  _globals['_RETRIEVEREQUEST']._serialized_end=107
-  _globals['_RETRIEVERESPONSE']._serialized_start=109
-  _globals['_RETRIEVERESPONSE']._serialized_end=176
-  _globals['_PASSAGE']._serialized_start=178
-  _globals['_PASSAGE']._serialized_end=219
-  _globals['_PASSAGERETRIEVALSERVICE']._serialized_start=221
-  _globals['_PASSAGERETRIEVALSERVICE']._serialized_end=337
+  _globals['_RETRIEVEREQUEST']._serialized_start=125
+  _globals['_RETRIEVEREQUEST']._serialized_end=218
+  _globals['_RETRIEVERESPONSE']._serialized_start=220
+  _globals['_RETRIEVERESPONSE']._serialized_end=287
+  _globals['_RETRIEVESTREAMRESPONSE']._serialized_start=289
+  _globals['_RETRIEVESTREAMRESPONSE']._serialized_end=361
+  _globals['_PASSAGE']._serialized_start=363
+  _globals['_PASSAGE']._serialized_end=404
+  _globals['_FILTER']._serialized_start=407
+  _globals['_FILTER']._serialized_end=562
+  _globals['_CONDITION']._serialized_start=565
+  _globals['_CONDITION']._serialized_end=870
+  _globals['_KEYWORDS']._serialized_start=872
+  _globals['_KEYWORDS']._serialized_end=898
+  _globals['_RANGE']._serialized_start=901
+  _globals['_RANGE']._serialized_end=1078
+  _globals['_DATETIMERANGE']._serialized_start=1081
+  _globals['_DATETIMERANGE']._serialized_end=1258
+  _globals['_PASSAGERETRIEVALSERVICE']._serialized_start=1261
+  _globals['_PASSAGERETRIEVALSERVICE']._serialized_end=1482
 # @@protoc_insertion_point(module_scope)
@@ -40,6 +40,11 @@ class PassageRetrievalServiceStub(object):
                 request_serializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveRequest.SerializeToString,
                 response_deserializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveResponse.FromString,
                 _registered_method=True)
+        self.RetrieveStream = channel.unary_stream(
+                '/retrieval.passage.v1.PassageRetrievalService/RetrieveStream',
+                request_serializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveRequest.SerializeToString,
+                response_deserializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveStreamResponse.FromString,
+                _registered_method=True)
 
 
 class PassageRetrievalServiceServicer(object):
@@ -53,6 +58,15 @@ class PassageRetrievalServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def RetrieveStream(self, request, context):
+        """패시지를 검색하고 찾은 결과를 점수가 높은 순서로 하나씩 보냅니다.
+        상위 결과를 먼저 받아 처리하는 동안 나머지 결과를 계속 검색합니다.
+        서버에 reranker가 설정되어 있으면 Retrieve와 같이 순위를 다시 매긴 뒤 보내므로, 첫 결과는 전체 후보의 순위를 매긴 다음에 도착합니다.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_PassageRetrievalServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -61,6 +75,11 @@ def add_PassageRetrievalServiceServicer_to_server(servicer, server):
                     request_deserializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveRequest.FromString,
                     response_serializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveResponse.SerializeToString,
             ),
+            'RetrieveStream': grpc.unary_stream_rpc_method_handler(
+                    servicer.RetrieveStream,
+                    request_deserializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveRequest.FromString,
+                    response_serializer=retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveStreamResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'retrieval.passage.v1.PassageRetrievalService', rpc_method_handlers)
@@ -99,3 +118,30 @@ class PassageRetrievalService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def RetrieveStream(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/retrieval.passage.v1.PassageRetrievalService/RetrieveStream',
+            retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveRequest.SerializeToString,
+            retrieval_dot_passage_dot_v1_dot_service__pb2.RetrieveStreamResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
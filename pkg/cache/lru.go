@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"container/list"
+	"iter"
+	"sync"
+)
+
+// 최근에 사용하지 않은 항목부터 제거하는 고정 크기 캐시. 여러 고루틴에서 동시에 사용할 수 있습니다.
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	// 앞쪽일수록 최근에 사용한 항목.
+	order *list.List
+	items map[K]*list.Element
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// 최대 capacity개의 항목을 저장하는 캐시를 생성합니다. capacity가 0 이하이면 아무것도 저장하지 않습니다.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[K]*list.Element),
+	}
+}
+
+// 키의 값을 조회합니다. 조회한 항목은 가장 최근에 사용한 항목이 됩니다.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*entry[K, V]).value, true
+}
+
+// 키의 값을 저장합니다. 캐시가 가득 차면 가장 오래전에 사용한 항목을 제거합니다.
+func (c *LRU[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.capacity <= 0 {
+		return
+	}
+
+	if e, ok := c.items[key]; ok {
+		e.Value.(*entry[K, V]).value = value
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: value})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry[K, V]).key)
+	}
+}
+
+// 키의 값을 제거합니다.
+func (c *LRU[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		c.order.Remove(e)
+		delete(c.items, key)
+	}
+}
+
+// 저장된 항목 수.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// 저장된 항목을 오래전에 사용한 항목부터 순회합니다. 순서를 바꾸지 않으므로 순서대로 Add하면 같은 캐시를 만들 수 있습니다.
+// 순회하는 동안 캐시를 잠그므로 yield에서 캐시를 사용하면 안 됩니다.
+func (c *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		for e := c.order.Back(); e != nil; e = e.Prev() {
+			ent := e.Value.(*entry[K, V])
+			if !yield(ent.key, ent.value) {
+				return
+			}
+		}
+	}
+}
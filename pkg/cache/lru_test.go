@@ -0,0 +1,165 @@
+package cache_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/devafterdark/project-lumos/pkg/cache"
+)
+
+func TestLRU(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		capacity int
+		run      func(c *cache.LRU[string, int])
+		expected map[string]int
+		missing  []string
+	}{
+		{
+			desc:     "get added values",
+			capacity: 2,
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+				c.Add("b", 2)
+			},
+			expected: map[string]int{"a": 1, "b": 2},
+		},
+		{
+			desc:     "evict least recently added",
+			capacity: 2,
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+				c.Add("b", 2)
+				c.Add("c", 3)
+			},
+			expected: map[string]int{"b": 2, "c": 3},
+			missing:  []string{"a"},
+		},
+		{
+			desc:     "get marks value as recently used",
+			capacity: 2,
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+				c.Add("b", 2)
+				c.Get("a")
+				c.Add("c", 3)
+			},
+			expected: map[string]int{"a": 1, "c": 3},
+			missing:  []string{"b"},
+		},
+		{
+			desc:     "add existing key replaces value",
+			capacity: 2,
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+				c.Add("b", 2)
+				c.Add("a", 10)
+				c.Add("c", 3)
+			},
+			expected: map[string]int{"a": 10, "c": 3},
+			missing:  []string{"b"},
+		},
+		{
+			desc:     "remove",
+			capacity: 2,
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+				c.Remove("a")
+			},
+			expected: map[string]int{},
+			missing:  []string{"a"},
+		},
+		{
+			desc:     "zero capacity stores nothing",
+			capacity: 0,
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+			},
+			expected: map[string]int{},
+			missing:  []string{"a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := cache.NewLRU[string, int](tc.capacity)
+			tc.run(c)
+
+			if c.Len() != len(tc.expected) {
+				t.Errorf("expected len %d, got %d", len(tc.expected), c.Len())
+			}
+			for key, want := range tc.expected {
+				if got, ok := c.Get(key); !ok || got != want {
+					t.Errorf("expected %s=%d, got %d (ok=%v)", key, want, got, ok)
+				}
+			}
+			for _, key := range tc.missing {
+				if _, ok := c.Get(key); ok {
+					t.Errorf("expected %s to be missing", key)
+				}
+			}
+		})
+	}
+}
+
+func TestLRUAll(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		run      func(c *cache.LRU[string, int])
+		expected []string
+	}{
+		{
+			desc:     "empty",
+			run:      func(c *cache.LRU[string, int]) {},
+			expected: nil,
+		},
+		{
+			desc: "least recently used first",
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+				c.Add("b", 2)
+				c.Add("c", 3)
+				c.Get("a")
+			},
+			expected: []string{"b", "c", "a"},
+		},
+		{
+			desc: "skip evicted values",
+			run: func(c *cache.LRU[string, int]) {
+				c.Add("a", 1)
+				c.Add("b", 2)
+				c.Add("c", 3)
+				c.Add("d", 4)
+			},
+			expected: []string{"b", "c", "d"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := cache.NewLRU[string, int](3)
+			tc.run(c)
+
+			var keys []string
+			for key := range c.All() {
+				keys = append(keys, key)
+			}
+			if !slices.Equal(keys, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, keys)
+			}
+
+			// 순회한 순서대로 다시 추가하면 같은 순서가 된다.
+			restored := cache.NewLRU[string, int](3)
+			for key, value := range c.All() {
+				restored.Add(key, value)
+			}
+			var restoredKeys []string
+			for key := range restored.All() {
+				restoredKeys = append(restoredKeys, key)
+			}
+			if !slices.Equal(restoredKeys, tc.expected) {
+				t.Errorf("expected restored %v, got %v", tc.expected, restoredKeys)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devafterdark/project-lumos/pkg/retry"
+)
+
+// 페이지 검색에 기본으로 포함하는 추가 속성.
+var DefaultExpand = []string{
+	"body.storage",
+	"version",
+	"space",
+	"history",
+	"metadata.labels",
+}
+
+type Client struct {
+	client *http.Client
+
+	// Confluence 기본 URL. e.g., "https://wiki.example.com"
+	BaseURL string
+	// 개인 액세스 토큰.
+	Token string
+}
+
+func NewClient(client *http.Client, baseURL, token string) *Client {
+	return &Client{
+		client:  client,
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Token:   token,
+	}
+}
+
+// 200이 아닌 응답의 오류.
+type Error struct {
+	// HTTP 상태 코드.
+	StatusCode int
+	// HTTP 상태. e.g., "429 Too Many Requests"
+	Status string
+	// Retry-After 헤더 값. 헤더가 없으면 0입니다.
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("confluence: %s", e.Status)
+}
+
+// CQL로 콘텐츠를 검색합니다.
+func (c *Client) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	expand := req.Expand
+	if expand == nil {
+		expand = DefaultExpand
+	}
+
+	q := url.Values{}
+	q.Set("cql", req.CQL)
+	q.Set("start", strconv.Itoa(req.Start))
+	q.Set("limit", strconv.Itoa(req.Limit))
+	q.Set("expand", strings.Join(expand, ","))
+
+	data, err := c.get(ctx, "/rest/api/content/search?"+q.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Warn("failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &Error{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			RetryAfter: retry.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	return io.ReadAll(resp.Body)
+}
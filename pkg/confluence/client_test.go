@@ -0,0 +1,82 @@
+package confluence_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/devafterdark/project-lumos/pkg/confluence"
+)
+
+func TestSearch(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		status     int
+		body       string
+		retryAfter string
+		wantErr    *confluence.Error
+		wantTitles []string
+	}{
+		{
+			desc:       "ok",
+			status:     http.StatusOK,
+			body:       `{ "results": [ { "id": "1", "type": "page", "title": "Page 1", "space": { "key": "DEV" }, "body": { "storage": { "value": "<p>a</p>" } }, "version": { "number": 2, "when": "2025-07-25T13:57:57.000+09:00" } } ], "start": 0, "limit": 25, "size": 1 }`,
+			wantTitles: []string{"Page 1"},
+		},
+		{
+			desc:       "too many requests",
+			status:     http.StatusTooManyRequests,
+			retryAfter: "5",
+			wantErr:    &confluence.Error{StatusCode: http.StatusTooManyRequests, RetryAfter: 5 * time.Second},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest/api/content/search" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if cql := r.URL.Query().Get("cql"); cql != "space = DEV" {
+					t.Errorf("unexpected cql %s", cql)
+				}
+				if auth := r.Header.Get("Authorization"); auth != "Bearer token" {
+					t.Errorf("unexpected authorization %s", auth)
+				}
+				if tc.retryAfter != "" {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			c := confluence.NewClient(server.Client(), server.URL+"/", "token")
+			resp, err := c.Search(t.Context(), &confluence.SearchRequest{CQL: "space = DEV", Limit: 25})
+
+			if tc.wantErr != nil {
+				var apiErr *confluence.Error
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("expected confluence.Error, got %v", err)
+				}
+				if apiErr.StatusCode != tc.wantErr.StatusCode || apiErr.RetryAfter != tc.wantErr.RetryAfter {
+					t.Errorf("expected %+v, got %+v", tc.wantErr, apiErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to search: %v", err)
+			}
+			if len(resp.Results) != len(tc.wantTitles) {
+				t.Fatalf("expected %d results, got %d", len(tc.wantTitles), len(resp.Results))
+			}
+			for i, title := range tc.wantTitles {
+				if resp.Results[i].Title != title {
+					t.Errorf("expected title %s, got %s", title, resp.Results[i].Title)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package confluence
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// 연속 빈 줄 패턴.
+	blankLinesRegex = regexp.MustCompile(`\n{3,}`)
+	// 줄 끝 공백 패턴.
+	trailingSpaceRegex = regexp.MustCompile(`[ \t]+\n`)
+)
+
+// 앞뒤에 빈 줄을 두는 블록 요소.
+var paragraphElements = map[string]bool{
+	"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"table": true, "pre": true, "blockquote": true, "ul": true, "ol": true,
+	"structured-macro": true, "layout-section": true,
+}
+
+// 앞뒤에 줄바꿈을 두는 블록 요소.
+var lineElements = map[string]bool{
+	"div": true, "li": true, "tr": true, "rich-text-body": true, "layout-cell": true,
+}
+
+// 내용을 출력하지 않는 요소.
+var skipElements = map[string]bool{
+	"parameter": true, "image": true, "style": true, "script": true, "placeholder": true,
+}
+
+// 닫는 태그 없이 쓸 수 있는 HTML 요소.
+// xml.HTMLAutoClose에는 ac:link와 이름이 같은 link가 포함되어 있어서 따로 정의한다.
+var autoCloseElements = []string{"br", "hr", "img", "col", "area", "input", "meta", "base"}
+
+// 저장 형식(XHTML) 본문을 일반 텍스트로 변환합니다. 임베딩 입력에 사용합니다.
+// 코드 매크로의 내용은 그대로 두고, 사용자 링크는 @이름, 페이지 링크는 링크 텍스트나 페이지 제목으로 바꿉니다.
+func StorageToText(storage string) string {
+	d := xml.NewDecoder(strings.NewReader("<root>" + storage + "</root>"))
+	d.Strict = false
+	d.AutoClose = autoCloseElements
+	d.Entity = xml.HTMLEntity
+
+	c := &storageConverter{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			// 잘못된 마크업이어도 그때까지 변환한 내용을 사용한다.
+			break
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			c.start(t)
+		case xml.EndElement:
+			c.end(t)
+		case xml.CharData:
+			c.text(string(t))
+		}
+	}
+
+	out := trailingSpaceRegex.ReplaceAllString(c.sb.String(), "\n")
+	return strings.TrimSpace(blankLinesRegex.ReplaceAllString(out, "\n\n"))
+}
+
+type storageConverter struct {
+	sb strings.Builder
+
+	// 내용을 출력하지 않는 요소의 깊이.
+	skip int
+	// 공백을 그대로 유지하는 요소(코드, pre)의 깊이.
+	preserve int
+	// 표의 현재 행에서 출력한 셀 수.
+	cells int
+	// 표 셀의 깊이. 셀 안의 블록 요소는 줄을 나누지 않고 공백으로 구분합니다.
+	inCell int
+	// 처리 중인 링크. 링크 텍스트가 없으면 대상 이름을 출력합니다.
+	links []*storageLink
+}
+
+type storageLink struct {
+	body     strings.Builder
+	fallback string
+}
+
+func (c *storageConverter) start(t xml.StartElement) {
+	name := t.Name.Local
+	if c.skip > 0 || skipElements[name] {
+		c.skip++
+		return
+	}
+
+	switch {
+	case name == "link":
+		c.links = append(c.links, &storageLink{})
+		return
+	case t.Name.Space == "ri" && len(c.links) > 0:
+		l := c.links[len(c.links)-1]
+		switch name {
+		case "user":
+			if user := attr(t, "username", "userkey", "account-id"); user != "" {
+				l.fallback = "@" + user
+			}
+		case "page", "blog-post", "attachment", "space":
+			l.fallback = attr(t, "content-title", "filename", "space-key")
+		}
+		return
+	case name == "plain-text-body" || name == "pre" || name == "code" && c.preserve > 0:
+		c.preserve++
+	case name == "br":
+		c.newline(1)
+	case name == "tr":
+		c.cells = 0
+	case name == "td" || name == "th":
+		if c.cells > 0 && strings.HasSuffix(c.sb.String(), " ") {
+			c.write("| ")
+		} else if c.cells > 0 {
+			c.write(" | ")
+		}
+		c.cells++
+		c.inCell++
+		return
+	}
+
+	c.block(name)
+	if name == "li" {
+		c.write("- ")
+	}
+}
+
+func (c *storageConverter) end(t xml.EndElement) {
+	name := t.Name.Local
+	if c.skip > 0 {
+		c.skip--
+		return
+	}
+
+	switch {
+	case name == "link" && len(c.links) > 0:
+		l := c.links[len(c.links)-1]
+		c.links = c.links[:len(c.links)-1]
+		text := strings.TrimSpace(l.body.String())
+		if text == "" {
+			text = l.fallback
+		}
+		c.write(text)
+		return
+	case t.Name.Space == "ri":
+		return
+	case name == "plain-text-body" || name == "pre" || name == "code" && c.preserve > 0:
+		c.preserve--
+	case name == "td" || name == "th":
+		c.inCell--
+		return
+	}
+
+	c.block(name)
+}
+
+// 블록 요소의 경계에서 줄을 나눕니다.
+func (c *storageConverter) block(name string) {
+	switch {
+	case !paragraphElements[name] && !lineElements[name]:
+	case c.inCell > 0:
+		if !c.atLineStart() && !strings.HasSuffix(c.sb.String(), " ") {
+			c.write(" ")
+		}
+	case paragraphElements[name]:
+		c.newline(2)
+	default:
+		c.newline(1)
+	}
+}
+
+func (c *storageConverter) text(s string) {
+	if c.skip > 0 {
+		return
+	}
+	if c.preserve == 0 {
+		s = collapseSpace(s)
+		// 줄의 시작에서는 공백을 출력하지 않는다.
+		if c.atLineStart() {
+			s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		}
+	}
+	c.write(s)
+}
+
+func (c *storageConverter) write(s string) {
+	if len(c.links) > 0 {
+		c.links[len(c.links)-1].body.WriteString(s)
+		return
+	}
+	c.sb.WriteString(s)
+}
+
+func (c *storageConverter) atLineStart() bool {
+	if len(c.links) > 0 {
+		return c.links[len(c.links)-1].body.Len() == 0
+	}
+	s := c.sb.String()
+	return s == "" || strings.HasSuffix(s, "\n") || strings.HasSuffix(s, "- ")
+}
+
+// 출력 끝에 줄바꿈이 n개 이상 있도록 합니다. 링크 안이나 출력의 시작에서는 무시합니다.
+func (c *storageConverter) newline(n int) {
+	if len(c.links) > 0 || c.sb.Len() == 0 {
+		return
+	}
+	s := c.sb.String()
+	have := len(s) - len(strings.TrimRight(s, "\n"))
+	for range n - have {
+		c.sb.WriteByte('\n')
+	}
+}
+
+func collapseSpace(s string) string {
+	var sb strings.Builder
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space {
+			sb.WriteByte(' ')
+			space = false
+		}
+		sb.WriteRune(r)
+	}
+	if space {
+		sb.WriteByte(' ')
+	}
+	return sb.String()
+}
+
+// 첫 번째로 값이 있는 속성을 반환합니다.
+func attr(t xml.StartElement, names ...string) string {
+	for _, name := range names {
+		for _, a := range t.Attr {
+			if a.Name.Local == name && a.Value != "" {
+				return a.Value
+			}
+		}
+	}
+	return ""
+}
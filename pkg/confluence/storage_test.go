@@ -0,0 +1,64 @@
+package confluence_test
+
+import (
+	"testing"
+
+	"github.com/devafterdark/project-lumos/pkg/confluence"
+)
+
+func TestStorageToText(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected string
+	}{
+		{
+			desc:     "paragraphs and entities",
+			value:    `<p>Hello&nbsp;<strong>world</strong> &amp; all</p><h2>Title</h2>`,
+			expected: "Hello world & all\n\nTitle",
+		},
+		{
+			desc:     "whitespace",
+			value:    "<p>\n  line   with\n  spaces\n</p>",
+			expected: "line with spaces",
+		},
+		{
+			desc:     "list",
+			value:    `<ul><li>one</li><li>two <em>x</em></li></ul>`,
+			expected: "- one\n- two x",
+		},
+		{
+			desc:     "links",
+			value:    `<p>see <ac:link><ri:user ri:username="hong" /></ac:link>, <ac:link><ri:page ri:content-title="Design Doc" /></ac:link> and <ac:link><ri:page ri:content-title="X" /><ac:plain-text-link-body><![CDATA[this page]]></ac:plain-text-link-body></ac:link></p>`,
+			expected: "see @hong, Design Doc and this page",
+		},
+		{
+			desc:     "code macro",
+			value:    "<ac:structured-macro ac:name=\"code\"><ac:parameter ac:name=\"language\">go</ac:parameter><ac:plain-text-body><![CDATA[func main() {\n\tfmt.Println(\"hi\")\n}]]></ac:plain-text-body></ac:structured-macro><p>after</p>",
+			expected: "func main() {\n\tfmt.Println(\"hi\")\n}\n\nafter",
+		},
+		{
+			desc:     "table",
+			value:    `<table><tbody><tr><th>Name</th><th>Value</th></tr><tr><td><p>a</p></td><td>b</td></tr></tbody></table>`,
+			expected: "Name | Value\na | b",
+		},
+		{
+			desc:     "rich text macro, line break and image",
+			value:    `<ac:structured-macro ac:name="info"><ac:rich-text-body><p>note</p></ac:rich-text-body></ac:structured-macro><p>line1<br/>line2</p><ac:image><ri:attachment ri:filename="a.png"/></ac:image>`,
+			expected: "note\n\nline1\nline2",
+		},
+		{
+			desc:     "malformed markup",
+			value:    `<p>unclosed <strong>text`,
+			expected: "unclosed text",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if actual := confluence.StorageToText(tc.value); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package confluence
+
+import "time"
+
+// Confluence 날짜 문자열을 해석합니다. e.g., "2025-07-25T13:57:57.000+09:00"
+func ParseTime(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339, s)
+}
+
+type SearchRequest struct {
+	// 검색 조건. e.g., `space = "DEV" AND type = page`
+	CQL string
+	// 조회 시작 위치.
+	Start int
+	// 한 번에 조회할 최대 개수.
+	Limit int
+	// 응답에 포함할 추가 속성. e.g., "body.storage", "version"
+	Expand []string
+}
+
+type SearchResponse struct {
+	// 조회한 콘텐츠 목록.
+	Results []Page `json:"results"`
+	// 조회 시작 위치.
+	Start int `json:"start"`
+	// 요청한 최대 개수.
+	Limit int `json:"limit"`
+	// 조회한 콘텐츠 수.
+	Size int `json:"size"`
+	// 조건에 맞는 전체 콘텐츠 수. 서버 버전에 따라 없을 수 있습니다.
+	TotalSize int `json:"totalSize"`
+	// 다음 페이지 링크.
+	Links Links `json:"_links"`
+}
+
+type Page struct {
+	// 페이지 ID. e.g., "123456"
+	ID string `json:"id"`
+	// 콘텐츠 유형. e.g., "page", "blogpost"
+	Type string `json:"type"`
+	// 콘텐츠 상태. e.g., "current"
+	Status string `json:"status"`
+	// 페이지 제목.
+	Title string `json:"title"`
+	// 페이지가 속한 스페이스.
+	Space Space `json:"space"`
+	// 페이지 본문.
+	Body Body `json:"body"`
+	// 페이지 버전.
+	Version Version `json:"version"`
+	// 페이지 작성 정보.
+	History History `json:"history"`
+	// 레이블 등 메타데이터.
+	Metadata Metadata `json:"metadata"`
+	// 페이지 링크.
+	Links Links `json:"_links"`
+}
+
+type Space struct {
+	// 스페이스 키. e.g., "DEV"
+	Key string `json:"key"`
+	// 스페이스 이름.
+	Name string `json:"name"`
+}
+
+type Body struct {
+	// 저장 형식(XHTML) 본문.
+	Storage Storage `json:"storage"`
+}
+
+type Storage struct {
+	// 본문 내용.
+	Value string `json:"value"`
+	// 본문 형식. e.g., "storage"
+	Representation string `json:"representation"`
+}
+
+type Version struct {
+	// 버전 번호.
+	Number int `json:"number"`
+	// 버전 작성일. (RFC 3339)
+	When string `json:"when"`
+	// 버전 작성자.
+	By User `json:"by"`
+}
+
+type History struct {
+	// 페이지 생성일. (RFC 3339)
+	CreatedDate string `json:"createdDate"`
+	// 페이지 생성자.
+	CreatedBy User `json:"createdBy"`
+}
+
+type User struct {
+	// 사용자 이름. Confluence Server에서만 제공됩니다.
+	Username string `json:"username"`
+	// 사용자 표시 이름.
+	DisplayName string `json:"displayName"`
+	// 사용자 이메일. 설정에 따라 비어 있을 수 있습니다.
+	Email string `json:"email"`
+}
+
+type Metadata struct {
+	Labels struct {
+		Results []Label `json:"results"`
+	} `json:"labels"`
+}
+
+type Label struct {
+	// 레이블 이름.
+	Name string `json:"name"`
+}
+
+type Links struct {
+	// Confluence 기본 URL. e.g., "https://wiki.example.com"
+	Base string `json:"base"`
+	// 페이지 웹 경로. e.g., "/pages/viewpage.action?pageId=123456"
+	WebUI string `json:"webui"`
+	// 다음 페이지 API 경로.
+	Next string `json:"next"`
+}
+
+// 페이지 레이블 이름 목록.
+func (p *Page) Labels() []string {
+	labels := make([]string, 0, len(p.Metadata.Labels.Results))
+	for _, l := range p.Metadata.Labels.Results {
+		labels = append(labels, l.Name)
+	}
+	return labels
+}
@@ -0,0 +1,219 @@
+package markup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Atlassian Document Format 노드. Jira Cloud의 본문과 코멘트 형식입니다.
+// https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+type adfNode struct {
+	Type    string         `json:"type"`
+	Text    string         `json:"text"`
+	Attrs   map[string]any `json:"attrs"`
+	Marks   []adfMark      `json:"marks"`
+	Content []adfNode      `json:"content"`
+}
+
+type adfMark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs"`
+}
+
+// s가 ADF 문서이면 블록 목록으로 해석합니다.
+func parseADF(s string) ([]block, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") {
+		return nil, false
+	}
+
+	var doc adfNode
+	if err := json.Unmarshal([]byte(s), &doc); err != nil || doc.Type != "doc" {
+		return nil, false
+	}
+
+	return adfBlocks(doc.Content), true
+}
+
+func adfBlocks(nodes []adfNode) []block {
+	var blocks []block
+	for _, n := range nodes {
+		switch n.Type {
+		case "paragraph":
+			blocks = append(blocks, block{kind: paragraphBlock, inlines: adfInlines(n.Content)})
+		case "heading":
+			blocks = append(blocks, block{kind: headingBlock, level: max(1, min(6, n.intAttr("level"))), inlines: adfInlines(n.Content)})
+		case "codeBlock":
+			blocks = append(blocks, block{kind: codeBlock, language: n.stringAttr("language"), code: adfText(n.Content)})
+		case "blockquote":
+			blocks = append(blocks, block{kind: quoteBlock, children: adfBlocks(n.Content)})
+		case "panel":
+			blocks = append(blocks, block{kind: panelBlock, children: adfBlocks(n.Content)})
+		case "expand", "nestedExpand":
+			b := block{kind: panelBlock, children: adfBlocks(n.Content)}
+			if title := n.stringAttr("title"); title != "" {
+				b.inlines = []inline{{kind: textInline, text: title}}
+			}
+			blocks = append(blocks, b)
+		case "bulletList", "orderedList":
+			blocks = append(blocks, adfList(n))
+		case "table":
+			blocks = append(blocks, adfTable(n))
+		case "rule":
+			blocks = append(blocks, block{kind: ruleBlock})
+		case "mediaSingle", "mediaGroup":
+			if inlines := adfInlines(n.Content); len(inlines) > 0 {
+				blocks = append(blocks, block{kind: paragraphBlock, inlines: inlines})
+			}
+		default:
+			// 알 수 없는 블록은 하위 블록만 사용한다.
+			blocks = append(blocks, adfBlocks(n.Content)...)
+		}
+	}
+	return blocks
+}
+
+func adfList(n adfNode) block {
+	b := block{kind: listBlock, ordered: n.Type == "orderedList"}
+	for _, item := range n.Content {
+		var li listItem
+		children := adfBlocks(item.Content)
+		// 항목의 첫 문단은 항목 내용으로, 나머지는 하위 블록으로 사용한다.
+		if len(children) > 0 && children[0].kind == paragraphBlock {
+			li.inlines, children = children[0].inlines, children[1:]
+		}
+		li.children = children
+		b.items = append(b.items, li)
+	}
+	return b
+}
+
+func adfTable(n adfNode) block {
+	b := block{kind: tableBlock}
+	for _, r := range n.Content {
+		row := tableRow{header: len(r.Content) > 0}
+		for _, c := range r.Content {
+			if c.Type != "tableHeader" {
+				row.header = false
+			}
+			// 셀 안의 문단은 줄바꿈으로 이어 붙인다.
+			var cell []inline
+			for i, p := range adfBlocks(c.Content) {
+				if i > 0 {
+					cell = append(cell, inline{kind: breakInline})
+				}
+				cell = append(cell, blockInlines(p)...)
+			}
+			row.cells = append(row.cells, cell)
+		}
+		b.rows = append(b.rows, row)
+	}
+	return b
+}
+
+// 표 셀처럼 인라인만 쓸 수 있는 곳에서 사용할 블록의 인라인 내용.
+func blockInlines(b block) []inline {
+	switch b.kind {
+	case codeBlock:
+		return []inline{{kind: codeInline, text: b.code}}
+	case listBlock:
+		var inlines []inline
+		for i, item := range b.items {
+			if i > 0 {
+				inlines = append(inlines, inline{kind: breakInline})
+			}
+			inlines = append(inlines, item.inlines...)
+		}
+		return inlines
+	default:
+		return b.inlines
+	}
+}
+
+func adfInlines(nodes []adfNode) []inline {
+	var inlines []inline
+	for _, n := range nodes {
+		var in inline
+		switch n.Type {
+		case "text":
+			in = inline{kind: textInline, text: n.Text}
+		case "hardBreak":
+			in = inline{kind: breakInline}
+		case "mention":
+			in = inline{kind: mentionInline, text: strings.TrimPrefix(n.stringAttr("text"), "@")}
+		case "emoji":
+			text := n.stringAttr("text")
+			if text == "" {
+				text = n.stringAttr("shortName")
+			}
+			in = inline{kind: textInline, text: text}
+		case "inlineCard", "blockCard", "embedCard":
+			in = inline{kind: linkInline, url: n.stringAttr("url")}
+		case "status":
+			in = inline{kind: textInline, text: fmt.Sprintf("[%s]", n.stringAttr("text"))}
+		case "date":
+			in = inline{kind: textInline, text: n.stringAttr("timestamp")}
+		case "media":
+			name := n.stringAttr("alt")
+			if name == "" {
+				name = n.stringAttr("id")
+			}
+			in = inline{kind: imageInline, text: name, url: n.stringAttr("url")}
+		default:
+			inlines = append(inlines, adfInlines(n.Content)...)
+			continue
+		}
+
+		// 안쪽 서식부터 감싼다.
+		for _, m := range n.Marks {
+			in = applyMark(in, m)
+		}
+		inlines = append(inlines, in)
+	}
+	return inlines
+}
+
+func applyMark(in inline, m adfMark) inline {
+	switch m.Type {
+	case "strong":
+		return inline{kind: strongInline, children: []inline{in}}
+	case "em":
+		return inline{kind: emphasisInline, children: []inline{in}}
+	case "strike":
+		return inline{kind: strikeInline, children: []inline{in}}
+	case "code":
+		if in.kind == textInline {
+			return inline{kind: codeInline, text: in.text}
+		}
+	case "link":
+		if href, _ := m.Attrs["href"].(string); href != "" {
+			return inline{kind: linkInline, url: href, children: []inline{in}}
+		}
+	}
+	// 밑줄, 글자색 등은 서식 없이 내용만 사용한다.
+	return in
+}
+
+// 코드 블록처럼 텍스트만 포함하는 노드의 내용.
+func adfText(nodes []adfNode) string {
+	var sb strings.Builder
+	for _, n := range nodes {
+		if n.Type == "hardBreak" {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(n.Text)
+		sb.WriteString(adfText(n.Content))
+	}
+	return sb.String()
+}
+
+func (n adfNode) stringAttr(key string) string {
+	v, _ := n.Attrs[key].(string)
+	return v
+}
+
+func (n adfNode) intAttr(key string) int {
+	v, _ := n.Attrs[key].(float64)
+	return int(v)
+}
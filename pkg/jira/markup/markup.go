@@ -0,0 +1,115 @@
+// Jira 본문(위키 마크업 또는 ADF)을 임베딩용 일반 텍스트와 표시용 Markdown, Slack mrkdwn으로 변환합니다.
+package markup
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 변환 결과를 정리할 때 사용하는 연속 빈 줄 패턴.
+var blankLinesRegex = regexp.MustCompile(`\n{3,}`)
+
+// Jira 본문을 서식 없는 일반 텍스트로 변환합니다. 임베딩 입력에 사용합니다.
+// ADF 문서(JSON)는 ADF로, 그 외에는 위키 마크업으로 해석합니다.
+func ToText(s string) string {
+	return convert(s, &textFormat)
+}
+
+// Jira 본문을 Markdown으로 변환합니다.
+func ToMarkdown(s string) string {
+	return convert(s, &markdownFormat)
+}
+
+// Jira 본문을 Slack mrkdwn으로 변환합니다.
+func ToSlack(s string) string {
+	return convert(s, &slackFormat)
+}
+
+func convert(s string, f *format) string {
+	blocks, ok := parseADF(s)
+	if !ok {
+		blocks = parseWiki(s)
+	}
+
+	out := (&renderer{format: f}).blocks(blocks)
+	return strings.TrimSpace(blankLinesRegex.ReplaceAllString(out, "\n\n"))
+}
+
+// IsADF는 s가 ADF 문서(JSON)인지 확인합니다.
+func IsADF(s string) bool {
+	_, ok := parseADF(s)
+	return ok
+}
+
+type blockKind int
+
+const (
+	paragraphBlock blockKind = iota
+	headingBlock
+	codeBlock
+	quoteBlock
+	panelBlock
+	listBlock
+	tableBlock
+	ruleBlock
+)
+
+// 문단, 제목, 코드, 목록 등 본문의 블록 요소.
+type block struct {
+	kind blockKind
+
+	// 문단, 제목, 패널 제목의 내용.
+	inlines []inline
+	// 제목 수준. (1~6)
+	level int
+	// 코드 블록의 언어와 내용.
+	language string
+	code     string
+	// 인용, 패널의 하위 블록.
+	children []block
+	// 목록 항목과 번호 목록 여부.
+	items   []listItem
+	ordered bool
+	// 표의 행 목록.
+	rows []tableRow
+}
+
+type listItem struct {
+	inlines []inline
+	// 하위 목록 등 항목에 포함된 블록.
+	children []block
+}
+
+type tableRow struct {
+	// 제목 행 여부.
+	header bool
+	cells  [][]inline
+}
+
+type inlineKind int
+
+const (
+	textInline inlineKind = iota
+	// 서식 없이 하위 요소만 출력하는 요소. (밑줄, 위/아래 첨자, 글자색 등)
+	spanInline
+	strongInline
+	emphasisInline
+	strikeInline
+	codeInline
+	linkInline
+	mentionInline
+	imageInline
+	breakInline
+)
+
+// 굵게, 링크, 멘션 등 문단 안의 인라인 요소.
+type inline struct {
+	kind inlineKind
+
+	// 텍스트, 코드, 멘션 이름, 이미지 대체 텍스트.
+	text string
+	// 링크 주소, 이미지 주소.
+	url string
+	// 서식, 링크의 하위 요소.
+	children []inline
+}
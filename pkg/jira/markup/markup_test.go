@@ -0,0 +1,256 @@
+package markup_test
+
+import (
+	"testing"
+
+	"github.com/devafterdark/project-lumos/pkg/jira/markup"
+)
+
+const (
+	sample_adf = `{"type":"doc","version":1,"content":[` +
+		`{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"재현 방법"}]},` +
+		`{"type":"paragraph","content":[` +
+		`{"type":"text","text":"굵게 ","marks":[{"type":"strong"}]},` +
+		`{"type":"text","text":"문서","marks":[{"type":"link","attrs":{"href":"https://example.com"}}]},` +
+		`{"type":"hardBreak"},` +
+		`{"type":"mention","attrs":{"id":"1","text":"@홍길동"}},` +
+		`{"type":"text","text":" 확인 "},` +
+		`{"type":"text","text":"make build","marks":[{"type":"code"}]}]},` +
+		`{"type":"bulletList","content":[{"type":"listItem","content":[` +
+		`{"type":"paragraph","content":[{"type":"text","text":"하나"}]},` +
+		`{"type":"orderedList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"둘"}]}]}]}]}]},` +
+		`{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println(\"a < b\")"}]}]}`
+)
+
+func TestToText(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected string
+	}{
+		{
+			desc:     "plain text",
+			value:    "평범한 문장입니다.",
+			expected: "평범한 문장입니다.",
+		},
+		{
+			desc:     "inline formatting",
+			value:    "*굵게* _기울임_ -취소- +밑줄+ ??인용?? {{code}}",
+			expected: "굵게 기울임 취소 밑줄 인용 code",
+		},
+		{
+			desc:     "marks inside words",
+			value:    "well-known 2025-07-25 a*b snake_case_name x = -1 and y = -2",
+			expected: "well-known 2025-07-25 a*b snake_case_name x = -1 and y = -2",
+		},
+		{
+			desc:     "heading",
+			value:    "h2. 재현 방법\n본문",
+			expected: "재현 방법\n\n본문",
+		},
+		{
+			desc:     "links and mentions",
+			value:    "[~hong] 님, [설계 문서|https://example.com/doc] 와 [https://example.com] 참고",
+			expected: "@hong 님, 설계 문서 와 https://example.com 참고",
+		},
+		{
+			desc:     "images and attachments",
+			value:    "화면 !screen.png|thumbnail! 첨부 [^log.txt]",
+			expected: "화면  첨부 log.txt",
+		},
+		{
+			desc:     "code block",
+			value:    "예시\n{code:java}\nint a = 1;\n  call();\n{code}\n끝",
+			expected: "예시\n\nint a = 1;\n  call();\n\n끝",
+		},
+		{
+			desc:     "noformat",
+			value:    "{noformat}*not bold*{noformat}",
+			expected: "*not bold*",
+		},
+		{
+			desc:     "nested list",
+			value:    "* 하나\n** 둘\n*# 셋\n* 넷",
+			expected: "- 하나\n  - 둘\n  1. 셋\n- 넷",
+		},
+		{
+			desc:     "table",
+			value:    "||이름||값||\n|a|[링크|https://example.com]|",
+			expected: "이름 | 값\na | 링크",
+		},
+		{
+			desc:     "quote and panel",
+			value:    "{quote}인용 *문장*{quote}\n{panel:title=주의}{color:red}빨강{color} (!){panel}",
+			expected: "인용 문장\n\n주의\n\n빨강 ⚠️",
+		},
+		{
+			desc:     "line break and escape",
+			value:    `첫 줄\\둘째 줄 \*별표\*`,
+			expected: "첫 줄\n둘째 줄 *별표*",
+		},
+		{
+			desc:     "adf",
+			value:    sample_adf,
+			expected: "재현 방법\n\n굵게 문서\n@홍길동 확인 make build\n\n- 하나\n  1. 둘\n\nfmt.Println(\"a < b\")",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if actual := markup.ToText(tc.value); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected string
+	}{
+		{
+			desc:     "inline formatting",
+			value:    "*굵게* _기울임_ -취소- {{code}}",
+			expected: "**굵게** _기울임_ ~~취소~~ `code`",
+		},
+		{
+			desc:     "heading",
+			value:    "h3. 제목",
+			expected: "### 제목",
+		},
+		{
+			desc:     "links and images",
+			value:    "[문서|https://example.com/doc] [https://example.com] !https://example.com/a.png!",
+			expected: "[문서](https://example.com/doc) <https://example.com> ![a.png](https://example.com/a.png)",
+		},
+		{
+			desc:     "code block",
+			value:    "{code:title=Main.java|language=java}\nclass Main {}\n{code}",
+			expected: "```java\nclass Main {}\n```",
+		},
+		{
+			desc:     "inline code tag is not a block",
+			value:    "{{code}} 태그",
+			expected: "`code` 태그",
+		},
+		{
+			desc:     "ordered list",
+			value:    "# 하나\n# 둘\n## 둘-하나",
+			expected: "1. 하나\n2. 둘\n   1. 둘-하나",
+		},
+		{
+			desc:     "table",
+			value:    "||이름||값||\n|a|b{{x|y}}|",
+			expected: "| 이름 | 값 |\n| --- | --- |\n| a | b`x\\|y` |",
+		},
+		{
+			desc:     "table without header",
+			value:    "|a|b|",
+			expected: "|  |  |\n| --- | --- |\n| a | b |",
+		},
+		{
+			desc:     "quote and rule",
+			value:    "bq. 인용\n----\n{panel:title=참고}패널{panel}",
+			expected: "> 인용\n\n---\n\n> **참고**\n>\n> 패널",
+		},
+		{
+			desc:     "adf",
+			value:    sample_adf,
+			expected: "## 재현 방법\n\n**굵게** [문서](https://example.com)\n@홍길동 확인 `make build`\n\n- 하나\n  1. 둘\n\n```go\nfmt.Println(\"a < b\")\n```",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if actual := markup.ToMarkdown(tc.value); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestToSlack(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected string
+	}{
+		{
+			desc:     "inline formatting",
+			value:    "*굵게* _기울임_ -취소- {{code}}",
+			expected: "*굵게* _기울임_ ~취소~ `code`",
+		},
+		{
+			desc:     "heading",
+			value:    "h1. 제목",
+			expected: "*제목*",
+		},
+		{
+			desc:     "links",
+			value:    "[문서|https://example.com/doc] [https://example.com] [~hong]",
+			expected: "<https://example.com/doc|문서> <https://example.com> @hong",
+		},
+		{
+			desc:     "escape",
+			value:    "a < b && c > d",
+			expected: "a &lt; b &amp;&amp; c &gt; d",
+		},
+		{
+			desc:     "list",
+			value:    "* 하나\n** 둘",
+			expected: "• 하나\n  • 둘",
+		},
+		{
+			desc:     "table",
+			value:    "||이름||값||\n|a|b|",
+			expected: "*이름* | *값*\na | b",
+		},
+		{
+			desc:     "adf",
+			value:    sample_adf,
+			expected: "*재현 방법*\n\n*굵게* <https://example.com|문서>\n@홍길동 확인 `make build`\n\n• 하나\n  1. 둘\n\n```\nfmt.Println(\"a &lt; b\")\n```",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if actual := markup.ToSlack(tc.value); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsADF(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected bool
+	}{
+		{
+			desc:     "adf document",
+			value:    sample_adf,
+			expected: true,
+		},
+		{
+			desc:     "wiki markup",
+			value:    "{code}x{code}",
+			expected: false,
+		},
+		{
+			desc:     "json that is not a document",
+			value:    `{"type":"paragraph"}`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if actual := markup.IsADF(tc.value); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package markup
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 출력 형식별 서식 규칙.
+type format struct {
+	// 인라인 서식 기호. 비어 있으면 서식 없이 내용만 출력합니다.
+	strong, emphasis, strike string
+	// 텍스트 이스케이프. nil이면 그대로 출력합니다.
+	escape func(s string) string
+	// 인라인 코드.
+	code func(s string) string
+	// 링크. text는 비어 있을 수 있습니다.
+	link func(text, url string) string
+	// 이미지. url은 첨부 파일 이름일 수 있습니다.
+	image     func(alt, url string) string
+	heading   func(level int, text string) string
+	codeBlock func(language, code string) string
+	// 인용문의 줄 앞에 붙이는 기호.
+	quote string
+	// 글머리 기호 목록의 기호.
+	bullet string
+	table  func(rows [][]string, header bool) string
+	rule   string
+}
+
+// 임베딩용 일반 텍스트. 링크 주소와 이미지는 검색에 도움이 되지 않으므로 제외합니다.
+var textFormat = format{
+	code: func(s string) string { return s },
+	link: func(text, url string) string {
+		if text == "" {
+			return url
+		}
+		return text
+	},
+	image:     func(alt, url string) string { return "" },
+	heading:   func(level int, text string) string { return text },
+	codeBlock: func(language, code string) string { return code },
+	bullet:    "- ",
+	table:     plainTable(func(s string) string { return s }),
+}
+
+var markdownFormat = format{
+	strong:   "**",
+	emphasis: "_",
+	strike:   "~~",
+	code:     markdownCode,
+	link: func(text, url string) string {
+		if text == "" || text == url {
+			return "<" + url + ">"
+		}
+		return fmt.Sprintf("[%s](%s)", text, url)
+	},
+	image: func(alt, url string) string { return fmt.Sprintf("![%s](%s)", alt, url) },
+	heading: func(level int, text string) string {
+		return strings.Repeat("#", level) + " " + text
+	},
+	codeBlock: func(language, code string) string {
+		return fmt.Sprintf("```%s\n%s\n```", language, code)
+	},
+	quote:  "> ",
+	bullet: "- ",
+	table:  markdownTable,
+	rule:   "---",
+}
+
+// Slack mrkdwn. https://api.slack.com/reference/surfaces/formatting
+var slackFormat = format{
+	strong:   "*",
+	emphasis: "_",
+	strike:   "~",
+	escape:   slackEscape,
+	code:     func(s string) string { return "`" + slackEscape(s) + "`" },
+	link: func(text, url string) string {
+		if text == "" || text == url {
+			return "<" + url + ">"
+		}
+		return fmt.Sprintf("<%s|%s>", url, text)
+	},
+	image: func(alt, url string) string {
+		if strings.Contains(url, "://") {
+			return fmt.Sprintf("<%s|%s>", url, slackEscape(alt))
+		}
+		return slackEscape(alt)
+	},
+	heading: func(level int, text string) string { return "*" + text + "*" },
+	codeBlock: func(language, code string) string {
+		return "```\n" + slackEscape(code) + "\n```"
+	},
+	quote:  "> ",
+	bullet: "• ",
+	table:  plainTable(func(s string) string { return "*" + s + "*" }),
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func slackEscape(s string) string {
+	return slackEscaper.Replace(s)
+}
+
+// 내용에 백틱이 있으면 더 긴 백틱으로 감쌉니다.
+func markdownCode(s string) string {
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		s = " " + s + " "
+	}
+	return fence + s + fence
+}
+
+func markdownTable(rows [][]string, header bool) string {
+	cols := 0
+	for _, row := range rows {
+		cols = max(cols, len(row))
+	}
+
+	line := func(cells []string) string {
+		var sb strings.Builder
+		sb.WriteString("|")
+		for i := range cols {
+			var cell string
+			if i < len(cells) {
+				cell = strings.ReplaceAll(cells[i], "|", `\|`)
+				cell = strings.ReplaceAll(cell, "\n", "<br>")
+			}
+			sb.WriteString(" " + cell + " |")
+		}
+		return sb.String()
+	}
+
+	// Markdown 표는 제목 행이 꼭 필요하므로 제목 행이 없으면 빈 제목 행을 만든다.
+	var lines []string
+	if header {
+		lines = append(lines, line(rows[0]))
+		rows = rows[1:]
+	} else {
+		lines = append(lines, line(nil))
+	}
+	lines = append(lines, "|"+strings.Repeat(" --- |", cols))
+	for _, row := range rows {
+		lines = append(lines, line(row))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// 표를 지원하지 않는 형식의 표. 셀을 " | "로 이어 한 줄에 한 행씩 출력합니다.
+func plainTable(headerCell func(s string) string) func(rows [][]string, header bool) string {
+	return func(rows [][]string, header bool) string {
+		lines := make([]string, 0, len(rows))
+		for i, row := range rows {
+			cells := make([]string, 0, len(row))
+			for _, cell := range row {
+				cell = strings.ReplaceAll(cell, "\n", " ")
+				if header && i == 0 && cell != "" {
+					cell = headerCell(cell)
+				}
+				cells = append(cells, cell)
+			}
+			lines = append(lines, strings.Join(cells, " | "))
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+type renderer struct {
+	format *format
+}
+
+// 블록 사이에는 빈 줄을 넣습니다. 내용이 없는 블록은 건너뜁니다.
+func (r *renderer) blocks(blocks []block) string {
+	parts := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		if s := r.block(b); strings.TrimSpace(s) != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+func (r *renderer) block(b block) string {
+	f := r.format
+
+	switch b.kind {
+	case headingBlock:
+		if text := strings.TrimSpace(r.inlines(b.inlines)); text != "" {
+			return f.heading(b.level, text)
+		}
+		return ""
+	case codeBlock:
+		return f.codeBlock(b.language, b.code)
+	case quoteBlock:
+		return prefixLines(r.blocks(b.children), f.quote)
+	case panelBlock:
+		body := r.blocks(b.children)
+		if title := strings.TrimSpace(r.inlines(b.inlines)); title != "" {
+			body = wrap(title, f.strong) + "\n\n" + body
+		}
+		return prefixLines(body, f.quote)
+	case listBlock:
+		return r.list(b)
+	case tableBlock:
+		rows := make([][]string, 0, len(b.rows))
+		for _, row := range b.rows {
+			cells := make([]string, 0, len(row.cells))
+			for _, cell := range row.cells {
+				cells = append(cells, strings.TrimSpace(r.inlines(cell)))
+			}
+			rows = append(rows, cells)
+		}
+		if len(rows) == 0 {
+			return ""
+		}
+		return f.table(rows, b.rows[0].header)
+	case ruleBlock:
+		return f.rule
+	default:
+		return strings.TrimSpace(r.inlines(b.inlines))
+	}
+}
+
+// 하위 블록은 항목 기호 길이만큼 들여씁니다.
+func (r *renderer) list(b block) string {
+	lines := make([]string, 0, len(b.items))
+	for i, item := range b.items {
+		marker := r.format.bullet
+		if b.ordered {
+			marker = fmt.Sprintf("%d. ", i+1)
+		}
+		indent := strings.Repeat(" ", len([]rune(marker)))
+
+		text := strings.TrimSpace(r.inlines(item.inlines))
+		lines = append(lines, marker+strings.ReplaceAll(text, "\n", "\n"+indent))
+		for _, child := range item.children {
+			if s := r.block(child); s != "" {
+				lines = append(lines, prefixLines(s, indent))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *renderer) inlines(inlines []inline) string {
+	var sb strings.Builder
+	for _, in := range inlines {
+		sb.WriteString(r.inline(in))
+	}
+	return sb.String()
+}
+
+func (r *renderer) inline(in inline) string {
+	f := r.format
+
+	switch in.kind {
+	case textInline:
+		if f.escape != nil {
+			return f.escape(in.text)
+		}
+		return in.text
+	case strongInline:
+		return wrap(r.inlines(in.children), f.strong)
+	case emphasisInline:
+		return wrap(r.inlines(in.children), f.emphasis)
+	case strikeInline:
+		return wrap(r.inlines(in.children), f.strike)
+	case codeInline:
+		trimmed := strings.TrimSpace(in.text)
+		if trimmed == "" {
+			return in.text
+		}
+		start := strings.Index(in.text, trimmed)
+		return in.text[:start] + f.code(trimmed) + in.text[start+len(trimmed):]
+	case linkInline:
+		return f.link(strings.TrimSpace(r.inlines(in.children)), in.url)
+	case mentionInline:
+		return "@" + in.text
+	case imageInline:
+		return f.image(in.text, in.url)
+	case breakInline:
+		return "\n"
+	default:
+		return r.inlines(in.children)
+	}
+}
+
+// 서식 기호로 내용을 감쌉니다. 앞뒤 공백은 기호 밖으로 옮겨야 서식이 적용됩니다.
+func wrap(s, mark string) string {
+	trimmed := strings.TrimSpace(s)
+	if mark == "" || trimmed == "" {
+		return s
+	}
+
+	start := strings.Index(s, trimmed)
+	return s[:start] + mark + trimmed + mark + s[start+len(trimmed):]
+}
+
+func prefixLines(s, prefix string) string {
+	if prefix == "" {
+		return s
+	}
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(prefix+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,438 @@
+package markup
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// 여러 줄에 걸친 블록 매크로의 시작 태그. e.g., {code:java}, {panel:title=제목}
+	blockMacroRegex = regexp.MustCompile(`\{(code|noformat|quote|panel|info|note|tip|warning)(?::([^}]*))?\}`)
+	headingRegex    = regexp.MustCompile(`^h([1-6])\.\s*(.*)$`)
+	quoteLineRegex  = regexp.MustCompile(`^bq\.\s*(.*)$`)
+	ruleRegex       = regexp.MustCompile(`^-{4,}$`)
+	listItemRegex   = regexp.MustCompile(`^([*#]+|-)\s+(.*)$`)
+	// 출력하지 않는 인라인 매크로. e.g., {color:red}, {color}, {anchor:name}
+	inlineMacroRegex = regexp.MustCompile(`^\{(?:color|anchor)(?::[^}]*)?\}`)
+	// 이미지로 볼 수 있는 !...! 사이의 내용. 파일 이름이나 URL이어야 합니다.
+	imageRegex = regexp.MustCompile(`^[^\s!|]+\.[^\s!|]+(\|[^!]*)?$`)
+)
+
+// 위키 마크업 이모티콘과 대응하는 유니코드 문자.
+var emoticons = []struct {
+	markup, text string
+}{
+	{"(/)", "✅"},
+	{"(x)", "❌"},
+	{"(!)", "⚠️"},
+	{"(?)", "❓"},
+	{"(i)", "ℹ️"},
+	{"(y)", "👍"},
+	{"(n)", "👎"},
+	{"(*)", "⭐"},
+	{"(on)", "💡"},
+	{"(off)", "💡"},
+}
+
+// 위키 마크업을 블록 목록으로 해석합니다.
+func parseWiki(s string) []block {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	var blocks []block
+	for {
+		loc := findBlockMacro(s)
+		if loc == nil {
+			return append(blocks, parseWikiLines(s)...)
+		}
+		blocks = append(blocks, parseWikiLines(s[:loc[0]])...)
+
+		name := s[loc[2]:loc[3]]
+		var params string
+		if loc[4] >= 0 {
+			params = s[loc[4]:loc[5]]
+		}
+
+		// 닫는 태그가 없으면 나머지 전체를 매크로 내용으로 본다.
+		body, rest := s[loc[1]:], ""
+		if end := strings.Index(body, "{"+name+"}"); end >= 0 {
+			body, rest = body[:end], body[end+len(name)+2:]
+		}
+		blocks = append(blocks, wikiMacro(name, params, body))
+		s = rest
+	}
+}
+
+// 첫 번째 블록 매크로의 위치를 찾습니다. {{code}}처럼 인라인 코드 안에 있는 태그는 건너뜁니다.
+func findBlockMacro(s string) []int {
+	for _, loc := range blockMacroRegex.FindAllStringSubmatchIndex(s, -1) {
+		if loc[0] > 0 && s[loc[0]-1] == '{' {
+			continue
+		}
+		return loc
+	}
+	return nil
+}
+
+func wikiMacro(name, params, body string) block {
+	switch name {
+	case "code", "noformat":
+		b := block{kind: codeBlock, code: trimBlankLines(body)}
+		if name == "code" {
+			// {code:java} 또는 {code:title=a.java|language=java} 형식.
+			for p := range strings.SplitSeq(params, "|") {
+				if k, v, ok := strings.Cut(p, "="); !ok && p != "" {
+					b.language = strings.TrimSpace(p)
+				} else if k == "language" {
+					b.language = strings.TrimSpace(v)
+				}
+			}
+		}
+		return b
+	case "quote":
+		return block{kind: quoteBlock, children: parseWiki(body)}
+	default:
+		b := block{kind: panelBlock, children: parseWiki(body)}
+		for p := range strings.SplitSeq(params, "|") {
+			if k, v, ok := strings.Cut(p, "="); ok && k == "title" {
+				b.inlines = parseInline(strings.TrimSpace(v))
+			}
+		}
+		return b
+	}
+}
+
+// 앞뒤의 빈 줄만 제거합니다. 코드의 들여쓰기는 유지합니다.
+func trimBlankLines(s string) string {
+	s = strings.TrimRight(s, " \t\n")
+	for {
+		line, rest, ok := strings.Cut(s, "\n")
+		if !ok || strings.TrimSpace(line) != "" {
+			return s
+		}
+		s = rest
+	}
+}
+
+// 블록 매크로가 없는 위키 마크업을 줄 단위로 해석합니다.
+func parseWikiLines(s string) []block {
+	var (
+		blocks    []block
+		paragraph []string
+		list      []wikiListLine
+		table     []tableRow
+	)
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			var inlines []inline
+			for i, line := range paragraph {
+				if i > 0 {
+					inlines = append(inlines, inline{kind: breakInline})
+				}
+				inlines = append(inlines, parseInline(line)...)
+			}
+			blocks = append(blocks, block{kind: paragraphBlock, inlines: inlines})
+			paragraph = nil
+		}
+		if len(list) > 0 {
+			blocks = append(blocks, buildList(list, 1))
+			list = nil
+		}
+		if len(table) > 0 {
+			blocks = append(blocks, block{kind: tableBlock, rows: table})
+			table = nil
+		}
+	}
+
+	for line := range strings.SplitSeq(s, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "":
+			flush()
+		case headingRegex.MatchString(line):
+			flush()
+			m := headingRegex.FindStringSubmatch(line)
+			blocks = append(blocks, block{kind: headingBlock, level: int(m[1][0] - '0'), inlines: parseInline(m[2])})
+		case quoteLineRegex.MatchString(line):
+			flush()
+			m := quoteLineRegex.FindStringSubmatch(line)
+			blocks = append(blocks, block{kind: quoteBlock, children: []block{{kind: paragraphBlock, inlines: parseInline(m[1])}}})
+		case ruleRegex.MatchString(line):
+			flush()
+			blocks = append(blocks, block{kind: ruleBlock})
+		case listItemRegex.MatchString(line):
+			if len(list) == 0 {
+				flush()
+			}
+			m := listItemRegex.FindStringSubmatch(line)
+			list = append(list, wikiListLine{markers: m[1], text: m[2]})
+		case strings.HasPrefix(line, "|"):
+			if len(table) == 0 {
+				flush()
+			}
+			table = append(table, parseTableRow(line))
+		default:
+			if len(list) > 0 || len(table) > 0 {
+				flush()
+			}
+			paragraph = append(paragraph, line)
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+type wikiListLine struct {
+	// 목록 기호. 길이가 들여쓰기 수준입니다. e.g., "*", "*#"
+	markers string
+	text    string
+}
+
+// 같은 수준(depth)의 목록 줄을 목록 블록으로 만듭니다. 더 깊은 줄은 직전 항목의 하위 목록이 됩니다.
+func buildList(lines []wikiListLine, depth int) block {
+	b := block{kind: listBlock, ordered: lines[0].markers[min(depth, len(lines[0].markers))-1] == '#'}
+
+	for i := 0; i < len(lines); {
+		if len(lines[i].markers) <= depth {
+			b.items = append(b.items, listItem{inlines: parseInline(lines[i].text)})
+			i++
+			continue
+		}
+
+		// 하위 목록의 종류(*, #)가 바뀌면 새 목록으로 나눈다.
+		j := i + 1
+		for j < len(lines) && len(lines[j].markers) > depth &&
+			(len(lines[j].markers) > depth+1 || lines[j].markers[depth] == lines[i].markers[depth]) {
+			j++
+		}
+		if len(b.items) == 0 {
+			b.items = append(b.items, listItem{})
+		}
+		last := &b.items[len(b.items)-1]
+		last.children = append(last.children, buildList(lines[i:j], depth+1))
+		i = j
+	}
+
+	return b
+}
+
+// 표의 한 행을 해석합니다. ||로 시작하면 제목 행입니다.
+func parseTableRow(line string) tableRow {
+	row := tableRow{header: strings.HasPrefix(line, "||")}
+
+	line = strings.TrimLeft(line, "|")
+	line = strings.TrimRight(line, "|")
+	for _, cell := range splitCells(line) {
+		row.cells = append(row.cells, parseInline(strings.TrimSpace(cell)))
+	}
+
+	return row
+}
+
+// | 또는 || 로 셀을 나눕니다. 링크([text|url])와 코드({{...}}) 안의 |는 나누지 않습니다.
+func splitCells(s string) []string {
+	var (
+		cells  []string
+		start  int
+		link   int
+		inCode bool
+	)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "{{"):
+			inCode = true
+			i++
+		case strings.HasPrefix(s[i:], "}}"):
+			inCode = false
+			i++
+		case inCode:
+		case s[i] == '[':
+			link++
+		case s[i] == ']' && link > 0:
+			link--
+		case s[i] == '|' && link == 0:
+			cells = append(cells, s[start:i])
+			for i+1 < len(s) && s[i+1] == '|' {
+				i++
+			}
+			start = i + 1
+		}
+	}
+	return append(cells, s[start:])
+}
+
+// 위키 마크업 서식 기호와 대응하는 인라인 요소.
+var wikiMarks = map[byte]inlineKind{
+	'*': strongInline,
+	'_': emphasisInline,
+	'-': strikeInline,
+	'+': spanInline,
+	'^': spanInline,
+	'~': spanInline,
+}
+
+// 한 줄의 위키 마크업을 인라인 요소로 해석합니다.
+func parseInline(s string) []inline {
+	var (
+		inlines []inline
+		text    strings.Builder
+	)
+	flushText := func() {
+		if text.Len() > 0 {
+			inlines = append(inlines, inline{kind: textInline, text: text.String()})
+			text.Reset()
+		}
+	}
+	add := func(in inline) {
+		flushText()
+		inlines = append(inlines, in)
+	}
+
+	for i := 0; i < len(s); {
+		rest := s[i:]
+
+		switch {
+		case strings.HasPrefix(rest, `\\`):
+			add(inline{kind: breakInline})
+			i += 2
+			continue
+		case rest[0] == '\\' && len(rest) > 1:
+			_, size := utf8.DecodeRuneInString(rest[1:])
+			text.WriteString(rest[1 : 1+size])
+			i += 1 + size
+			continue
+		case strings.HasPrefix(rest, "{{"):
+			if end := strings.Index(rest[2:], "}}"); end > 0 {
+				add(inline{kind: codeInline, text: rest[2 : 2+end]})
+				i += end + 4
+				continue
+			}
+		case rest[0] == '{':
+			if m := inlineMacroRegex.FindString(rest); m != "" {
+				i += len(m)
+				continue
+			}
+		case rest[0] == '[':
+			if end := strings.IndexByte(rest, ']'); end > 1 {
+				add(parseLink(rest[1:end]))
+				i += end + 1
+				continue
+			}
+		case rest[0] == '!':
+			if end := strings.IndexByte(rest[1:], '!'); end > 0 && imageRegex.MatchString(rest[1:1+end]) {
+				src, _, _ := strings.Cut(rest[1:1+end], "|")
+				add(inline{kind: imageInline, text: src[strings.LastIndexByte(src, '/')+1:], url: src})
+				i += end + 2
+				continue
+			}
+		case rest[0] == '(':
+			if e, ok := matchEmoticon(rest); ok {
+				text.WriteString(e.text)
+				i += len(e.markup)
+				continue
+			}
+		case strings.HasPrefix(rest, "??"):
+			if end, ok := closingMark(s, i, "??"); ok {
+				add(inline{kind: emphasisInline, children: parseInline(s[i+2 : end])})
+				i = end + 2
+				continue
+			}
+		}
+
+		if kind, ok := wikiMarks[rest[0]]; ok {
+			if end, ok := closingMark(s, i, rest[:1]); ok {
+				add(inline{kind: kind, children: parseInline(s[i+1 : end])})
+				i = end + 1
+				continue
+			}
+		}
+
+		text.WriteByte(s[i])
+		i++
+	}
+	flushText()
+
+	return inlines
+}
+
+// [...] 안의 내용을 링크, 멘션, 첨부 파일로 해석합니다.
+func parseLink(content string) inline {
+	switch {
+	case strings.HasPrefix(content, "~"):
+		name := strings.TrimPrefix(content[1:], "accountid:")
+		return inline{kind: mentionInline, text: name}
+	case strings.HasPrefix(content, "^"):
+		return inline{kind: textInline, text: content[1:]}
+	}
+
+	label, url, ok := strings.Cut(content, "|")
+	if !ok {
+		url, label = content, ""
+	}
+	url = strings.TrimSpace(url)
+
+	var children []inline
+	if label != "" {
+		children = parseInline(label)
+	}
+	if strings.HasPrefix(url, "#") {
+		// 문서 안의 앵커는 주소로 쓸 수 없으므로 텍스트만 남긴다.
+		if children == nil {
+			children = []inline{{kind: textInline, text: url[1:]}}
+		}
+		return inline{kind: spanInline, children: children}
+	}
+	return inline{kind: linkInline, url: url, children: children}
+}
+
+func matchEmoticon(s string) (struct{ markup, text string }, bool) {
+	for _, e := range emoticons {
+		if strings.HasPrefix(s, e.markup) {
+			return e, true
+		}
+	}
+	return struct{ markup, text string }{}, false
+}
+
+// s[start]에서 시작하는 서식 기호(mark)의 닫는 위치를 찾습니다.
+// 여는 기호는 단어 중간에 있으면 안 되고 바로 뒤에 공백이 없어야 하며,
+// 닫는 기호는 바로 앞에 공백이 없고 단어 중간에 있으면 안 됩니다.
+func closingMark(s string, start int, mark string) (int, bool) {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(s[:start])
+		if isWordRune(r) || strings.HasPrefix(mark, string(r)) {
+			return 0, false
+		}
+	}
+	open := start + len(mark)
+	if open >= len(s) {
+		return 0, false
+	}
+	if r, _ := utf8.DecodeRuneInString(s[open:]); unicode.IsSpace(r) {
+		return 0, false
+	}
+
+	for i := open + 1; i+len(mark) <= len(s); i++ {
+		if !strings.HasPrefix(s[i:], mark) {
+			continue
+		}
+		if r, _ := utf8.DecodeLastRuneInString(s[:i]); unicode.IsSpace(r) {
+			continue
+		}
+		if after := i + len(mark); after < len(s) {
+			if r, _ := utf8.DecodeRuneInString(s[after:]); isWordRune(r) {
+				continue
+			}
+		}
+		return i, true
+	}
+	return 0, false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,36 @@
+package jira
+
+import "time"
+
+// Jira 날짜 문자열을 해석합니다. (2006-01-02T15:04:05Z0700)
+func ParseTime(s string) (time.Time, error) {
+	return time.Parse(TimeFormat, s)
+}
+
+// 이슈 생성일.
+func (f IssueFields) CreatedTime() (time.Time, error) {
+	return ParseTime(f.Created)
+}
+
+// 이슈 수정일.
+func (f IssueFields) UpdatedTime() (time.Time, error) {
+	return ParseTime(f.Updated)
+}
+
+// 이슈 해결일. 해결되지 않았으면 zero time을 반환합니다.
+func (f IssueFields) ResolutionTime() (time.Time, error) {
+	if f.ResolutionDate == "" {
+		return time.Time{}, nil
+	}
+	return ParseTime(f.ResolutionDate)
+}
+
+// 코멘트 작성일.
+func (c Comment) CreatedTime() (time.Time, error) {
+	return ParseTime(c.Created)
+}
+
+// 코멘트 수정일.
+func (c Comment) UpdatedTime() (time.Time, error) {
+	return ParseTime(c.Updated)
+}
@@ -1,5 +1,10 @@
 package jira
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 const (
 	TimeFormat = "2006-01-02T15:04:05Z0700"
 )
@@ -32,6 +37,113 @@ type IssueFields struct {
 	Created string `json:"created"`
 	// 이슈 수정일.
 	Updated string `json:"updated"`
+	// 이슈 해결일. 해결되지 않았으면 빈 문자열입니다.
+	ResolutionDate string `json:"resolutiondate"`
+	// 우선순위.
+	Priority Priority `json:"priority"`
+	// 이슈 유형.
+	IssueType IssueType `json:"issuetype"`
+	// 해결 상태. 해결되지 않았으면 빈 값입니다.
+	Resolution Resolution `json:"resolution"`
+	// 컴포넌트 목록.
+	Components []Component `json:"components"`
+	// 수정 버전 목록.
+	FixVersions []Version `json:"fixVersions"`
+	// 연결된 이슈 목록.
+	IssueLinks []IssueLink `json:"issuelinks"`
+	// 상위 이슈. 하위 작업이 아니면 nil입니다.
+	Parent *LinkedIssue `json:"parent,omitempty"`
+	// 하위 작업 목록.
+	Subtasks []LinkedIssue `json:"subtasks"`
+	// 첨부 파일 목록.
+	Attachments []Attachment `json:"attachment"`
+	// 사용자 정의 필드. 필드 ID를 키로 하는 원본 JSON 값입니다. e.g., "customfield_10010"
+	CustomFields map[string]json.RawMessage `json:"-"`
+}
+
+// 사용자 정의 필드의 ID 접두사.
+const customFieldPrefix = "customfield_"
+
+type issueFields IssueFields
+
+// 사용자 정의 필드를 CustomFields에 모읍니다.
+// Jira Cloud의 ADF 본문(JSON 객체)은 JSON 문자열 그대로 Content에 저장합니다.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*issueFields
+		Content json.RawMessage `json:"description"`
+	}{
+		issueFields: (*issueFields)(f),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	content, err := documentText(aux.Content)
+	if err != nil {
+		return err
+	}
+	f.Content = content
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	f.CustomFields = nil
+	for key, value := range raw {
+		if !strings.HasPrefix(key, customFieldPrefix) || string(value) == "null" {
+			continue
+		}
+		if f.CustomFields == nil {
+			f.CustomFields = make(map[string]json.RawMessage)
+		}
+		f.CustomFields[key] = value
+	}
+
+	return nil
+}
+
+// 사용자 정의 필드를 다른 필드와 같은 수준에 기록합니다.
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(issueFields(f))
+	if err != nil || len(f.CustomFields) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range f.CustomFields {
+		fields[key] = value
+	}
+
+	return json.Marshal(fields)
+}
+
+// 사용자 정의 필드 값을 v로 디코딩합니다. 필드가 없으면 false를 반환합니다.
+func (f IssueFields) CustomField(id string, v any) (bool, error) {
+	value, ok := f.CustomFields[id]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(value, v)
+}
+
+// 본문 필드 값을 문자열로 변환합니다. 위키 마크업 문자열은 그대로, ADF 문서는 JSON 문자열로 반환합니다.
+func documentText(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+	if raw[0] != '"' {
+		return string(raw), nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", err
+	}
+	return s, nil
 }
 
 type User struct {
@@ -48,6 +160,113 @@ type Status struct {
 	Name string `json:"name"`
 }
 
+type Priority struct {
+	// 우선순위 ID. e.g., "3"
+	ID string `json:"id"`
+	// 우선순위 이름. e.g., "Major"
+	Name string `json:"name"`
+}
+
+type IssueType struct {
+	// 이슈 유형 ID. e.g., "1"
+	ID string `json:"id"`
+	// 이슈 유형 이름. e.g., "Bug", "Task"
+	Name string `json:"name"`
+	// 하위 작업 유형 여부.
+	Subtask bool `json:"subtask"`
+}
+
+type Resolution struct {
+	// 해결 상태 ID. e.g., "1"
+	ID string `json:"id"`
+	// 해결 상태 이름. e.g., "Fixed", "Duplicate"
+	Name string `json:"name"`
+}
+
+type Component struct {
+	// 컴포넌트 ID. e.g., "10100"
+	ID string `json:"id"`
+	// 컴포넌트 이름.
+	Name string `json:"name"`
+}
+
+type Version struct {
+	// 버전 ID. e.g., "10200"
+	ID string `json:"id"`
+	// 버전 이름. e.g., "1.2.0"
+	Name string `json:"name"`
+	// 릴리스 여부.
+	Released bool `json:"released"`
+	// 릴리스 날짜. (2006-01-02)
+	ReleaseDate string `json:"releaseDate"`
+}
+
+type IssueLink struct {
+	// 연결 ID.
+	ID string `json:"id"`
+	// 연결 유형.
+	Type IssueLinkType `json:"type"`
+	// 이 이슈를 가리키는 이슈. e.g., "is duplicated by" 관계의 상대 이슈
+	InwardIssue *LinkedIssue `json:"inwardIssue,omitempty"`
+	// 이 이슈가 가리키는 이슈. e.g., "duplicates" 관계의 상대 이슈
+	OutwardIssue *LinkedIssue `json:"outwardIssue,omitempty"`
+}
+
+type IssueLinkType struct {
+	// 연결 유형 이름. e.g., "Duplicate", "Blocks"
+	Name string `json:"name"`
+	// 상대 이슈가 이 이슈를 가리킬 때의 관계. e.g., "is blocked by"
+	Inward string `json:"inward"`
+	// 이 이슈가 상대 이슈를 가리킬 때의 관계. e.g., "blocks"
+	Outward string `json:"outward"`
+}
+
+// 연결된 이슈, 상위 이슈, 하위 작업처럼 요약 정보만 포함하는 이슈.
+type LinkedIssue struct {
+	// 이슈 ID.
+	ID string `json:"id"`
+	// 이슈 번호.
+	Key string `json:"key"`
+	// 이슈 요약 필드.
+	Fields LinkedIssueFields `json:"fields"`
+}
+
+type LinkedIssueFields struct {
+	// 이슈 제목.
+	Title string `json:"summary"`
+	// 이슈 상태.
+	Status Status `json:"status"`
+	// 우선순위.
+	Priority Priority `json:"priority"`
+	// 이슈 유형.
+	IssueType IssueType `json:"issuetype"`
+}
+
+// 연결 관계와 상대 이슈를 반환합니다. e.g., ("blocks", AA-2)
+func (l IssueLink) Relation() (string, *LinkedIssue) {
+	if l.OutwardIssue != nil {
+		return l.Type.Outward, l.OutwardIssue
+	}
+	return l.Type.Inward, l.InwardIssue
+}
+
+type Attachment struct {
+	// 첨부 파일 ID.
+	ID string `json:"id"`
+	// 파일 이름.
+	Filename string `json:"filename"`
+	// 업로드한 사용자.
+	Author User `json:"author"`
+	// 업로드 날짜. (2006-01-02T15:04:05Z0700)
+	Created string `json:"created"`
+	// 파일 크기. (bytes)
+	Size int64 `json:"size"`
+	// MIME 타입. e.g., "image/png"
+	MimeType string `json:"mimeType"`
+	// 파일 다운로드 URL.
+	Content string `json:"content"`
+}
+
 type CommentInfo struct {
 	// 코멘트 총 개수.
 	Total int `json:"total"`
@@ -67,3 +286,26 @@ type Comment struct {
 	// 코멘트 수정일. (2006-01-02T15:04:05Z0700)
 	Updated string `json:"updated"`
 }
+
+type comment Comment
+
+// Jira Cloud의 ADF 코멘트(JSON 객체)는 JSON 문자열 그대로 Body에 저장합니다.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*comment
+		Body json.RawMessage `json:"body"`
+	}{
+		comment: (*comment)(c),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	body, err := documentText(aux.Body)
+	if err != nil {
+		return err
+	}
+	c.Body = body
+
+	return nil
+}
@@ -96,3 +96,177 @@ func TestUnmarshalIssue(t *testing.T) {
 		})
 	}
 }
+
+const (
+	sample_json_3 = `{ "fields": { "summary": "Issue Summary", "description": { "type": "doc", "version": 1, "content": [] }, "priority": { "id": "3", "name": "Major" }, "issuetype": { "id": "5", "name": "Sub-task", "subtask": true }, "resolution": { "id": "1", "name": "Fixed" }, "resolutiondate": "2025-07-26T09:00:00.000+0900", "components": [ { "id": "10100", "name": "Backend" } ], "fixVersions": [ { "id": "10200", "name": "1.2.0", "released": true, "releaseDate": "2025-08-01" } ], "issuelinks": [ { "id": "1", "type": { "name": "Duplicate", "inward": "is duplicated by", "outward": "duplicates" }, "outwardIssue": { "id": "185700", "key": "AA-11111", "fields": { "summary": "Original", "status": { "name": "Resolved" } } } }, { "id": "2", "type": { "name": "Blocks", "inward": "is blocked by", "outward": "blocks" }, "inwardIssue": { "id": "185701", "key": "AA-22222", "fields": { "summary": "Blocker" } } } ], "parent": { "id": "185600", "key": "AA-10000", "fields": { "summary": "Parent" } }, "subtasks": [], "attachment": [ { "id": "300", "filename": "screen.png", "size": 1024, "mimeType": "image/png", "content": "https://jira.example.com/secure/attachment/300/screen.png", "created": "2025-07-25T11:20:55.000+0900" } ], "comment": { "comments": [ { "id": "1", "body": { "type": "doc", "version": 1, "content": [] }, "created": "2025-07-25T13:57:57.000+0900", "updated": "2025-07-25T13:57:57.000+0900" } ], "total": 1 }, "created": "2025-07-25T11:20:55.000+0900", "updated": "2025-07-25T13:59:32.000+0900", "customfield_10010": "Sprint 1", "customfield_10020": { "value": "High" }, "customfield_10030": null }, "id": "185731", "key": "AA-12346" }`
+)
+
+func TestUnmarshalIssueFields(t *testing.T) {
+	issue := jira.Issue{}
+	if err := json.Unmarshal([]byte(sample_json_3), &issue); err != nil {
+		t.Fatalf("failed to unmarshal issue: %v", err)
+	}
+	fields := issue.Fields
+
+	testCases := []struct {
+		desc     string
+		actual   any
+		expected any
+	}{
+		{desc: "priority", actual: fields.Priority.Name, expected: "Major"},
+		{desc: "issue type", actual: fields.IssueType.Subtask, expected: true},
+		{desc: "resolution", actual: fields.Resolution.Name, expected: "Fixed"},
+		{desc: "components", actual: len(fields.Components), expected: 1},
+		{desc: "fix versions", actual: fields.FixVersions[0].Name, expected: "1.2.0"},
+		{desc: "parent", actual: fields.Parent.Key, expected: "AA-10000"},
+		{desc: "attachments", actual: fields.Attachments[0].Size, expected: int64(1024)},
+		{desc: "adf description", actual: fields.Content, expected: `{ "type": "doc", "version": 1, "content": [] }`},
+		{desc: "adf comment", actual: fields.CommentInfo.Comments[0].Body, expected: `{ "type": "doc", "version": 1, "content": [] }`},
+		{desc: "custom fields", actual: len(fields.CustomFields), expected: 2},
+		{desc: "custom field value", actual: string(fields.CustomFields["customfield_10010"]), expected: `"Sprint 1"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, tc.actual)
+			}
+		})
+	}
+}
+
+func TestIssueLinkRelation(t *testing.T) {
+	issue := jira.Issue{}
+	if err := json.Unmarshal([]byte(sample_json_3), &issue); err != nil {
+		t.Fatalf("failed to unmarshal issue: %v", err)
+	}
+
+	testCases := []struct {
+		desc     string
+		link     jira.IssueLink
+		relation string
+		key      string
+	}{
+		{
+			desc:     "outward",
+			link:     issue.Fields.IssueLinks[0],
+			relation: "duplicates",
+			key:      "AA-11111",
+		},
+		{
+			desc:     "inward",
+			link:     issue.Fields.IssueLinks[1],
+			relation: "is blocked by",
+			key:      "AA-22222",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			relation, linked := tc.link.Relation()
+			if relation != tc.relation {
+				t.Errorf("expected relation %s, got %s", tc.relation, relation)
+			}
+			if linked == nil || linked.Key != tc.key {
+				t.Errorf("expected linked issue %s, got %v", tc.key, linked)
+			}
+		})
+	}
+}
+
+func TestCustomField(t *testing.T) {
+	issue := jira.Issue{}
+	if err := json.Unmarshal([]byte(sample_json_3), &issue); err != nil {
+		t.Fatalf("failed to unmarshal issue: %v", err)
+	}
+
+	// 다시 인코딩해도 사용자 정의 필드가 유지되어야 한다.
+	data, err := json.Marshal(issue)
+	if err != nil {
+		t.Fatalf("failed to marshal issue: %v", err)
+	}
+	decoded := jira.Issue{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal marshaled issue: %v", err)
+	}
+
+	testCases := []struct {
+		desc     string
+		id       string
+		found    bool
+		expected string
+	}{
+		{
+			desc:     "object value",
+			id:       "customfield_10020",
+			found:    true,
+			expected: "High",
+		},
+		{
+			desc:  "null value",
+			id:    "customfield_10030",
+			found: false,
+		},
+		{
+			desc:  "missing field",
+			id:    "customfield_99999",
+			found: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var value struct {
+				Value string `json:"value"`
+			}
+			found, err := decoded.Fields.CustomField(tc.id, &value)
+			if err != nil {
+				t.Fatalf("failed to decode custom field: %v", err)
+			}
+			if found != tc.found {
+				t.Errorf("expected found %v, got %v", tc.found, found)
+			}
+			if value.Value != tc.expected {
+				t.Errorf("expected value %s, got %s", tc.expected, value.Value)
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{
+			desc:     "jira time",
+			value:    "2025-07-25T13:57:57.000+0900",
+			expected: time.Date(2025, 7, 25, 4, 57, 57, 0, time.UTC),
+		},
+		{
+			desc:    "invalid time",
+			value:   "2025-07-25 13:57",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actual, err := jira.ParseTime(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to parse time: %v", err)
+			}
+			if !actual.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package retry
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// HTTP 응답의 Retry-After 헤더 값을 대기 시간으로 바꿉니다.
+// 초 단위 숫자와 HTTP 날짜 형식을 모두 지원하며, 값이 없거나 해석할 수 없으면 0을 반환합니다.
+// 이미 지난 날짜도 0을 반환합니다.
+func ParseRetryAfter(value string) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return 0
+}
@@ -0,0 +1,65 @@
+package retry_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/devafterdark/project-lumos/pkg/retry"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected time.Duration
+		// HTTP 날짜는 현재 시각 기준이므로 허용 오차를 둔다.
+		tolerance time.Duration
+	}{
+		{
+			desc:     "seconds",
+			value:    "120",
+			expected: 2 * time.Minute,
+		},
+		{
+			desc:     "zero seconds",
+			value:    "0",
+			expected: 0,
+		},
+		{
+			desc:     "empty",
+			value:    "",
+			expected: 0,
+		},
+		{
+			desc:     "negative seconds",
+			value:    "-1",
+			expected: 0,
+		},
+		{
+			desc:     "invalid",
+			value:    "soon",
+			expected: 0,
+		},
+		{
+			desc:      "http date",
+			value:     time.Now().Add(time.Minute).UTC().Format(http.TimeFormat),
+			expected:  time.Minute,
+			tolerance: 2 * time.Second,
+		},
+		{
+			desc:     "past http date",
+			value:    time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat),
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := retry.ParseRetryAfter(tc.value)
+			if got < tc.expected-tc.tolerance || got > tc.expected+tc.tolerance {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package credential_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	passagev1 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/client"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/server"
+)
+
+type fakeService struct{}
+
+func (fakeService) Retrieve(ctx context.Context, query string, limit int32, filter *passagev1.Filter) ([]*passagev1.Passage, error) {
+	return []*passagev1.Passage{{Score: 1}}, nil
+}
+
+func (fakeService) RetrieveStream(ctx context.Context, query string, limit int32, filter *passagev1.Filter, send func(*passagev1.Passage) error) error {
+	return nil
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCertificate(t, dir, "ca", nil)
+	newCertificate(t, dir, "server", ca)
+	newCertificate(t, dir, "client", ca)
+	other := newCertificate(t, dir, "other-ca", nil)
+	newCertificate(t, dir, "other-client", other)
+
+	file := func(name string) string { return filepath.Join(dir, name) }
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := server.NewServer(
+		server.WithListener(lis),
+		server.WithServiceV1(fakeService{}),
+		server.WithTLS(file("server.pem"), file("server-key.pem")),
+		server.WithClientCA(file("ca.pem")),
+		server.WithTokens("secret"),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Serve(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+
+	testCases := []struct {
+		desc     string
+		opts     []client.Option
+		expected codes.Code
+	}{
+		{
+			desc: "allow client certificate and token",
+			opts: []client.Option{
+				client.WithTLS(file("ca.pem")),
+				client.WithClientCertificate(file("client.pem"), file("client-key.pem")),
+				client.WithToken("secret"),
+			},
+			expected: codes.OK,
+		},
+		{
+			desc: "reject missing token",
+			opts: []client.Option{
+				client.WithTLS(file("ca.pem")),
+				client.WithClientCertificate(file("client.pem"), file("client-key.pem")),
+			},
+			expected: codes.Unauthenticated,
+		},
+		{
+			desc: "reject invalid token",
+			opts: []client.Option{
+				client.WithTLS(file("ca.pem")),
+				client.WithClientCertificate(file("client.pem"), file("client-key.pem")),
+				client.WithToken("wrong"),
+			},
+			expected: codes.Unauthenticated,
+		},
+		{
+			desc: "reject missing client certificate",
+			opts: []client.Option{
+				client.WithTLS(file("ca.pem")),
+				client.WithToken("secret"),
+			},
+			expected: codes.Unavailable,
+		},
+		{
+			desc: "reject client certificate from unknown CA",
+			opts: []client.Option{
+				client.WithTLS(file("ca.pem")),
+				client.WithClientCertificate(file("other-client.pem"), file("other-client-key.pem")),
+				client.WithToken("secret"),
+			},
+			expected: codes.Unavailable,
+		},
+		{
+			desc: "reject untrusted server certificate",
+			opts: []client.Option{
+				client.WithTLS(file("other-ca.pem")),
+				client.WithClientCertificate(file("client.pem"), file("client-key.pem")),
+				client.WithToken("secret"),
+			},
+			expected: codes.Unavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			opts := append([]client.Option{client.WithHost("127.0.0.1"), client.WithPort(port)}, tc.opts...)
+			c, err := client.NewClient(opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer c.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, err = c.RetrievePassagesV1(ctx, "query", 1)
+			if got := status.Code(err); got != tc.expected {
+				t.Errorf("expected code %v, got %v (%v)", tc.expected, got, err)
+			}
+		})
+	}
+}
+
+type certificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// name.pem과 name-key.pem을 dir에 만듭니다. parent가 nil이면 자체 서명한 CA 인증서를 만듭니다.
+func newCertificate(t *testing.T, dir, name string, parent *certificate) *certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	writePEM(t, filepath.Join(dir, name+".pem"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, name+"-key.pem"), "EC PRIVATE KEY", keyDER)
+
+	return &certificate{cert: cert, key: key}
+}
+
+func writePEM(t *testing.T, path, blockType string, data []byte) {
+	t.Helper()
+
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
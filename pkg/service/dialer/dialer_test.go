@@ -0,0 +1,227 @@
+package dialer_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	passagev1 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/dialer"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/client"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/server"
+)
+
+func TestServiceConfig(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		retry    *dialer.RetryPolicy
+		expected string
+		wantErr  bool
+	}{
+		{
+			desc:     "round robin without retry",
+			expected: `{"loadBalancingConfig":[{"round_robin":{}}]}`,
+		},
+		{
+			desc:  "retry policy for services",
+			retry: &dialer.DefaultRetryPolicy,
+			expected: `{"loadBalancingConfig":[{"round_robin":{}}],"methodConfig":[{"name":[{"service":"a.Service"}],` +
+				`"retryPolicy":{"maxAttempts":3,"initialBackoff":"0.1s","maxBackoff":"1s","backoffMultiplier":2,"retryableStatusCodes":["UNAVAILABLE"]}}]}`,
+		},
+		{
+			desc:     "single attempt disables retry",
+			retry:    &dialer.RetryPolicy{MaxAttempts: 1},
+			expected: `{"loadBalancingConfig":[{"round_robin":{}}]}`,
+		},
+		{
+			desc:    "retry without codes",
+			retry:   &dialer.RetryPolicy{MaxAttempts: 2},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := dialer.ServiceConfig([]string{"a.Service"}, tc.retry)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error = %v, got %v", tc.wantErr, err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnaryTimeout(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		desc        string
+		ctx         func() (context.Context, context.CancelFunc)
+		timeout     time.Duration
+		hasDeadline bool
+		keepsOwn    bool
+	}{
+		{
+			desc:        "set deadline when missing",
+			ctx:         func() (context.Context, context.CancelFunc) { return context.Background(), func() {} },
+			timeout:     time.Second,
+			hasDeadline: true,
+		},
+		{
+			desc: "keep existing deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithDeadline(context.Background(), deadline)
+			},
+			timeout:     time.Second,
+			hasDeadline: true,
+			keepsOwn:    true,
+		},
+		{
+			desc: "no deadline when timeout is zero",
+			ctx:  func() (context.Context, context.CancelFunc) { return context.Background(), func() {} },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := tc.ctx()
+			defer cancel()
+
+			var (
+				got time.Time
+				ok  bool
+			)
+			invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				got, ok = ctx.Deadline()
+				return nil
+			}
+			if err := dialer.UnaryTimeout(tc.timeout)(ctx, "/a.Service/Method", nil, nil, nil, invoker); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != tc.hasDeadline {
+				t.Errorf("expected deadline = %v, got %v", tc.hasDeadline, ok)
+			}
+			if tc.keepsOwn && !got.Equal(deadline) {
+				t.Errorf("expected deadline %v, got %v", deadline, got)
+			}
+		})
+	}
+}
+
+type fakeService struct {
+	score float32
+	// 남은 횟수만큼 Unavailable을 반환합니다.
+	failures atomic.Int32
+	calls    atomic.Int32
+}
+
+func (s *fakeService) Retrieve(ctx context.Context, query string, limit int32, filter *passagev1.Filter) ([]*passagev1.Passage, error) {
+	s.calls.Add(1)
+	if s.failures.Add(-1) >= 0 {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return []*passagev1.Passage{{Score: s.score}}, nil
+}
+
+func (s *fakeService) RetrieveStream(ctx context.Context, query string, limit int32, filter *passagev1.Filter, send func(*passagev1.Passage) error) error {
+	return nil
+}
+
+func serve(t *testing.T, addr string, svc *fakeService) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := server.NewServer(server.WithListener(lis), server.WithServiceV1(svc))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Serve(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+	return port
+}
+
+func TestClient(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		failures int32
+		opts     []client.Option
+		calls    int
+		expected codes.Code
+		// 응답한 백엔드의 점수 목록.
+		scores []float32
+	}{
+		{
+			desc:     "balance across static hosts",
+			calls:    4,
+			expected: codes.OK,
+			scores:   []float32{1, 2},
+		},
+		{
+			desc:     "retry unavailable",
+			failures: 1,
+			calls:    1,
+			expected: codes.OK,
+		},
+		{
+			desc:     "fail without retry",
+			failures: 1,
+			opts:     []client.Option{client.WithoutRetry()},
+			calls:    1,
+			expected: codes.Unavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			first := &fakeService{score: 1}
+			second := &fakeService{score: 2}
+			first.failures.Store(tc.failures)
+			second.failures.Store(tc.failures)
+			port := serve(t, "127.0.0.1:0", first)
+			serve(t, net.JoinHostPort("127.0.0.2", port), second)
+
+			opts := append([]client.Option{
+				client.WithHosts("127.0.0.1", "127.0.0.2"),
+				client.WithPort(port),
+				client.WithTimeout(5 * time.Second),
+			}, tc.opts...)
+			c, err := client.NewClient(opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer c.Close()
+
+			seen := make(map[float32]bool)
+			for range tc.calls {
+				passages, err := c.RetrievePassagesV1(context.Background(), "query", 1)
+				if got := status.Code(err); got != tc.expected {
+					t.Fatalf("expected code %v, got %v (%v)", tc.expected, got, err)
+				}
+				for _, p := range passages {
+					seen[p.Score] = true
+				}
+			}
+			for _, score := range tc.scores {
+				if !seen[score] {
+					t.Errorf("expected backend with score %v to be called", score)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package dialer
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// 고정된 백엔드 목록을 사용하는 리졸버의 스킴.
+const staticScheme = "static"
+
+// hosts가 하나면 DNS로 주소를 찾는 대상을, 여러 개면 hosts를 그대로 사용하는 대상과 리졸버 옵션을 반환합니다.
+// DNS가 여러 주소를 반환하거나 hosts가 여러 개면 서비스 설정의 round_robin으로 요청을 나눠 보냅니다.
+func Target(hosts []string, port string) (string, []grpc.DialOption) {
+	if len(hosts) == 1 {
+		return "dns:///" + net.JoinHostPort(hosts[0], port), nil
+	}
+
+	addrs := make([]resolver.Address, 0, len(hosts))
+	for _, host := range hosts {
+		addrs = append(addrs, resolver.Address{
+			Addr: net.JoinHostPort(host, port),
+			// TLS 서버 인증서는 각 백엔드의 호스트 이름으로 확인한다.
+			ServerName: host,
+		})
+	}
+
+	r := manual.NewBuilderWithScheme(staticScheme)
+	r.InitialState(resolver.State{Addresses: addrs})
+
+	return staticScheme + ":///" + net.JoinHostPort(hosts[0], port), []grpc.DialOption{grpc.WithResolvers(r)}
+}
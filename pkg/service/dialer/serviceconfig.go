@@ -0,0 +1,100 @@
+package dialer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+)
+
+// gRPC 서비스 설정의 재시도 정책.
+type RetryPolicy struct {
+	// 첫 시도를 포함한 최대 시도 횟수. 2 이상이어야 재시도합니다.
+	MaxAttempts int
+	// 첫 재시도 전 최대 대기 시간. 실제 대기 시간은 0과 이 값 사이에서 무작위로 정해집니다.
+	InitialBackoff time.Duration
+	// 재시도 대기 시간의 상한.
+	MaxBackoff time.Duration
+	// 재시도마다 대기 시간에 곱하는 값.
+	BackoffMultiplier float64
+	// 재시도할 상태 코드. e.g., codes.Unavailable
+	RetryableCodes []codes.Code
+}
+
+// 서버에 연결할 수 없을 때 최대 3번까지 시도하는 기본 재시도 정책.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:       3,
+	InitialBackoff:    100 * time.Millisecond,
+	MaxBackoff:        time.Second,
+	BackoffMultiplier: 2,
+	RetryableCodes:    []codes.Code{codes.Unavailable},
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy retryPolicy  `json:"retryPolicy"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// 연결된 모든 백엔드에 요청을 번갈아 보내고(round_robin), services의 모든 메서드에 retry 정책을 적용하는 서비스 설정 JSON을 만듭니다.
+// retry가 nil이면 재시도하지 않습니다.
+func ServiceConfig(services []string, retry *RetryPolicy) (string, error) {
+	config := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}},
+	}
+
+	if retry != nil && retry.MaxAttempts > 1 {
+		if len(retry.RetryableCodes) == 0 {
+			return "", errors.New("retry policy requires at least one retryable code")
+		}
+		names := make([]methodName, 0, len(services))
+		for _, s := range services {
+			names = append(names, methodName{Service: s})
+		}
+		statusCodes := make([]string, 0, len(retry.RetryableCodes))
+		for _, c := range retry.RetryableCodes {
+			// 서비스 설정은 상태 코드를 UNAVAILABLE 같은 google.rpc.Code 이름으로 받는다.
+			statusCodes = append(statusCodes, code.Code_name[int32(c)])
+		}
+		config.MethodConfig = []methodConfig{{
+			Name: names,
+			RetryPolicy: retryPolicy{
+				MaxAttempts:          retry.MaxAttempts,
+				InitialBackoff:       seconds(retry.InitialBackoff),
+				MaxBackoff:           seconds(retry.MaxBackoff),
+				BackoffMultiplier:    retry.BackoffMultiplier,
+				RetryableStatusCodes: statusCodes,
+			},
+		}}
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// 서비스 설정의 시간 형식. e.g., "0.1s"
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
@@ -0,0 +1,52 @@
+package dialer
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// 컨텍스트에 기한이 없는 호출에 timeout을 기한으로 설정합니다.
+func UnaryTimeout(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// 컨텍스트에 기한이 없는 스트림에 timeout을 기한으로 설정합니다. 기한은 스트림 전체에 적용됩니다.
+func StreamTimeout(timeout time.Duration) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		return &clientStream{ClientStream: stream, cancel: cancel}, nil
+	}
+}
+
+// 스트림이 끝나면 기한 컨텍스트를 정리하는 스트림.
+type clientStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+package healthcheck
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// 의존성 상태를 확인합니다. 정상이면 nil을 반환합니다.
+type Check func(ctx context.Context) error
+
+// 이름이 붙은 의존성 검사. e.g., "qdrant", "embedder"
+type NamedCheck struct {
+	Name  string
+	Check Check
+}
+
+type Checker struct {
+	server   *health.Server
+	services []string
+	checks   []NamedCheck
+	interval time.Duration
+}
+
+// services는 상태를 갱신할 gRPC 서비스 이름 목록입니다. 서버 전체 상태("")는 항상 함께 갱신합니다.
+// interval은 검사 주기이자 검사 하나의 제한 시간입니다.
+func NewChecker(server *health.Server, services []string, checks []NamedCheck, interval time.Duration) *Checker {
+	return &Checker{
+		server:   server,
+		services: append([]string{""}, services...),
+		checks:   checks,
+		interval: interval,
+	}
+}
+
+// 검사를 한 번 실행하고 결과 상태를 반영합니다. 검사가 하나라도 실패하면 NOT_SERVING입니다.
+func (c *Checker) Update(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	status := healthpb.HealthCheckResponse_SERVING
+	for _, check := range c.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, c.interval)
+		err := check.Check(checkCtx)
+		cancel()
+		if err != nil {
+			slog.Warn("health check failed", "check", check.Name, "error", err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+	return status
+}
+
+// ctx가 끝날 때까지 interval마다 검사를 실행합니다. 검사가 없으면 SERVING으로 설정하고 바로 반환합니다.
+func (c *Checker) Run(ctx context.Context) {
+	last := c.Update(ctx)
+	if len(c.checks) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if status := c.Update(ctx); status != last {
+				slog.Info("health status changed", "from", last.String(), "to", status.String())
+				last = status
+			}
+		}
+	}
+}
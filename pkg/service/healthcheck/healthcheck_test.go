@@ -0,0 +1,66 @@
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/devafterdark/project-lumos/pkg/service/healthcheck"
+)
+
+func TestCheckerUpdate(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	fail := func(context.Context) error { return errors.New("unreachable") }
+
+	testCases := []struct {
+		desc     string
+		checks   []healthcheck.NamedCheck
+		expected healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			desc:     "serving without checks",
+			expected: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			desc: "serving when all checks pass",
+			checks: []healthcheck.NamedCheck{
+				{Name: "qdrant", Check: ok},
+				{Name: "embedder", Check: ok},
+			},
+			expected: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			desc: "not serving when any check fails",
+			checks: []healthcheck.NamedCheck{
+				{Name: "qdrant", Check: ok},
+				{Name: "embedder", Check: fail},
+			},
+			expected: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			server := health.NewServer()
+			services := []string{"retrieval.passage.v1.PassageRetrievalService"}
+			checker := healthcheck.NewChecker(server, services, tc.checks, time.Second)
+
+			if got := checker.Update(context.Background()); got != tc.expected {
+				t.Errorf("expected status %v, got %v", tc.expected, got)
+			}
+			for _, service := range append([]string{""}, services...) {
+				resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+				if err != nil {
+					t.Fatalf("unexpected error checking %q: %v", service, err)
+				}
+				if resp.Status != tc.expected {
+					t.Errorf("expected %q status %v, got %v", service, tc.expected, resp.Status)
+				}
+			}
+		})
+	}
+}
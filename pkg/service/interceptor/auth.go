@@ -0,0 +1,65 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 헬스 체크와 리플렉션은 토큰 없이 호출할 수 있습니다.
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.",
+	"/grpc.reflection.",
+}
+
+// authorization 메타데이터의 bearer 토큰이 tokens 중 하나와 같은지 확인합니다. 다르면 Unauthenticated를 반환합니다.
+func UnaryAuth(tokens []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authenticate(ctx, info.FullMethod, tokens); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamAuth(tokens []string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticate(ss.Context(), info.FullMethod, tokens); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authenticate(ctx context.Context, method string, tokens []string) error {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return nil
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+
+	// 토큰 비교 시간으로 토큰 내용을 추측할 수 없도록 모든 토큰을 상수 시간으로 비교한다.
+	valid := 0
+	for _, t := range tokens {
+		valid |= subtle.ConstantTimeCompare([]byte(token), []byte(t))
+	}
+	if valid != 1 {
+		return status.Error(codes.Unauthenticated, "invalid authorization token")
+	}
+	return nil
+}
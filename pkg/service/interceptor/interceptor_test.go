@@ -0,0 +1,167 @@
+package interceptor_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/devafterdark/project-lumos/pkg/service/interceptor"
+)
+
+var info = &grpc.UnaryServerInfo{FullMethod: "/retrieval.passage.v1.PassageRetrievalService/Retrieve"}
+
+func TestUnaryRequestID(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		md       metadata.MD
+		expected string
+	}{
+		{
+			desc:     "use request id from metadata",
+			md:       metadata.Pairs(interceptor.RequestIDKey, "req-1"),
+			expected: "req-1",
+		},
+		{
+			desc: "generate request id when missing",
+			md:   metadata.MD{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+
+			var got string
+			_, err := interceptor.UnaryRequestID()(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+				got = interceptor.RequestIDFromContext(ctx)
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expected != "" && got != tc.expected {
+				t.Errorf("expected request id %q, got %q", tc.expected, got)
+			}
+			if got == "" {
+				t.Error("expected request id to be set")
+			}
+		})
+	}
+}
+
+func TestUnaryRecovery(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		handler  grpc.UnaryHandler
+		expected codes.Code
+	}{
+		{
+			desc:     "pass through result",
+			handler:  func(ctx context.Context, req any) (any, error) { return "ok", nil },
+			expected: codes.OK,
+		},
+		{
+			desc: "pass through error",
+			handler: func(ctx context.Context, req any) (any, error) {
+				return nil, status.Error(codes.NotFound, "not found")
+			},
+			expected: codes.NotFound,
+		},
+		{
+			desc:     "recover panic as internal",
+			handler:  func(ctx context.Context, req any) (any, error) { panic("boom") },
+			expected: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := interceptor.UnaryRecovery()(context.Background(), nil, info, tc.handler)
+			if got := status.Code(err); got != tc.expected {
+				t.Errorf("expected code %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		errs     []error
+		expected map[codes.Code]int64
+	}{
+		{
+			desc:     "count successful calls",
+			errs:     []error{nil, nil},
+			expected: map[codes.Code]int64{codes.OK: 2},
+		},
+		{
+			desc: "count calls by code",
+			errs: []error{
+				nil,
+				status.Error(codes.InvalidArgument, "bad"),
+				errors.New("plain error"),
+			},
+			expected: map[codes.Code]int64{codes.OK: 1, codes.InvalidArgument: 1, codes.Unknown: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			m := interceptor.NewMetrics()
+			for _, err := range tc.errs {
+				_, _ = m.UnaryServerInterceptor()(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+					return nil, err
+				})
+			}
+
+			stats := m.Snapshot()[info.FullMethod]
+			if stats.Count() != int64(len(tc.errs)) {
+				t.Errorf("expected count %d, got %d", len(tc.errs), stats.Count())
+			}
+			for code, want := range tc.expected {
+				if got := stats.Codes[code]; got != want {
+					t.Errorf("expected %v count %d, got %d", code, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMetricsString(t *testing.T) {
+	m := interceptor.NewMetrics()
+	for _, err := range []error{nil, status.Error(codes.NotFound, "missing"), nil} {
+		_, _ = m.UnaryServerInterceptor()(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			return nil, err
+		})
+	}
+
+	var got map[string]struct {
+		Count  int64            `json:"count"`
+		Errors int64            `json:"errors"`
+		Codes  map[string]int64 `json:"codes"`
+	}
+	if err := json.Unmarshal([]byte(m.String()), &got); err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+
+	stats, ok := got[info.FullMethod]
+	if !ok {
+		t.Fatalf("expected stats of %s, got %v", info.FullMethod, got)
+	}
+	if stats.Count != 3 {
+		t.Errorf("expected count 3, got %d", stats.Count)
+	}
+	if stats.Errors != 1 {
+		t.Errorf("expected errors 1, got %d", stats.Errors)
+	}
+	if stats.Codes["OK"] != 2 || stats.Codes["NotFound"] != 1 {
+		t.Errorf("expected codes OK=2 NotFound=1, got %v", stats.Codes)
+	}
+}
@@ -0,0 +1,149 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 메서드별 호출 결과와 처리 시간을 집계합니다. 여러 고루틴에서 동시에 사용할 수 있습니다.
+// expvar.Var이므로 expvar.Publish로 등록하면 실행 중에도 /debug/vars에서 집계를 확인할 수 있습니다.
+type Metrics struct {
+	mu      sync.Mutex
+	methods map[string]*MethodStats
+}
+
+var _ expvar.Var = (*Metrics)(nil)
+
+// 메서드 하나의 집계.
+type MethodStats struct {
+	// 상태 코드별 호출 수.
+	Codes map[codes.Code]int64
+	// 처리 시간 합계.
+	TotalLatency time.Duration
+	// 가장 오래 걸린 처리 시간.
+	MaxLatency time.Duration
+}
+
+// 전체 호출 수.
+func (s MethodStats) Count() int64 {
+	var n int64
+	for _, c := range s.Codes {
+		n += c
+	}
+	return n
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		methods: make(map[string]*MethodStats),
+	}
+}
+
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observe(info.FullMethod, status.Code(err), time.Since(start))
+		return resp, err
+	}
+}
+
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observe(info.FullMethod, status.Code(err), time.Since(start))
+		return err
+	}
+}
+
+func (m *Metrics) observe(method string, code codes.Code, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats, ok := m.methods[method]
+	if !ok {
+		stats = &MethodStats{Codes: make(map[codes.Code]int64)}
+		m.methods[method] = stats
+	}
+	stats.Codes[code]++
+	stats.TotalLatency += latency
+	stats.MaxLatency = max(stats.MaxLatency, latency)
+}
+
+// 메서드 이름을 키로 하는 현재 집계의 복사본을 반환합니다.
+func (m *Metrics) Snapshot() map[string]MethodStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]MethodStats, len(m.methods))
+	for method, stats := range m.methods {
+		snapshot[method] = MethodStats{
+			Codes:        maps.Clone(stats.Codes),
+			TotalLatency: stats.TotalLatency,
+			MaxLatency:   stats.MaxLatency,
+		}
+	}
+	return snapshot
+}
+
+// 메서드별 호출 수, 오류 수, 평균/최대 처리 시간을 로그로 남깁니다.
+func (m *Metrics) LogSummary() {
+	for method, stats := range m.Snapshot() {
+		count := stats.Count()
+		slog.Info("grpc method stats",
+			"method", method,
+			"count", count,
+			"errors", count-stats.Codes[codes.OK],
+			"avg_latency", stats.TotalLatency/time.Duration(count),
+			"max_latency", stats.MaxLatency,
+		)
+	}
+}
+
+// expvar로 공개하는 메서드 하나의 집계. 처리 시간은 밀리초 단위입니다.
+type methodVar struct {
+	Count        int64            `json:"count"`
+	Errors       int64            `json:"errors"`
+	Codes        map[string]int64 `json:"codes"`
+	AvgLatencyMs float64          `json:"avg_latency_ms"`
+	MaxLatencyMs float64          `json:"max_latency_ms"`
+}
+
+// 메서드 이름을 키로 하는 현재 집계를 JSON으로 반환합니다.
+func (m *Metrics) String() string {
+	vars := make(map[string]methodVar)
+	for method, stats := range m.Snapshot() {
+		count := stats.Count()
+		byCode := make(map[string]int64, len(stats.Codes))
+		for code, n := range stats.Codes {
+			byCode[code.String()] = n
+		}
+		vars[method] = methodVar{
+			Count:        count,
+			Errors:       count - stats.Codes[codes.OK],
+			Codes:        byCode,
+			AvgLatencyMs: milliseconds(stats.TotalLatency / time.Duration(count)),
+			MaxLatencyMs: milliseconds(stats.MaxLatency),
+		}
+	}
+
+	data, err := json.Marshal(vars)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,69 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// 요청 ID를 주고받는 메타데이터 키.
+const RequestIDKey = "x-request-id"
+
+type requestIDKey struct{}
+
+// 요청 컨텍스트의 요청 ID를 반환합니다. 없으면 빈 문자열입니다.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// 메타데이터의 요청 ID를 컨텍스트에 담고 응답 트레일러로 돌려줍니다. 요청 ID가 없으면 새로 만듭니다.
+// 응답 헤더를 보내면 클라이언트가 실패한 호출을 재시도할 수 없으므로 트레일러를 사용합니다.
+func UnaryRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+func StreamRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = newRequestID()
+	}
+
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(RequestIDKey, id)); err != nil {
+		slog.Warn("failed to set request id header", "error", err)
+	}
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// 컨텍스트를 바꾼 스트림.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
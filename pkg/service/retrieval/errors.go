@@ -0,0 +1,214 @@
+package retrieval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 검색 서비스가 반환하는 오류의 종류. errors.Is로 확인합니다.
+var (
+	// 요청이 잘못되었습니다. (InvalidArgument)
+	ErrInvalidArgument = errors.New("invalid argument")
+	// 검색에 필요한 외부 서비스에 연결할 수 없습니다. 잠시 후 다시 시도할 수 있습니다. (Unavailable)
+	ErrUnavailable = errors.New("upstream unavailable")
+	// 요청한 리소스가 없습니다. (NotFound)
+	ErrNotFound = errors.New("not found")
+)
+
+// 잘못된 요청 필드.
+type FieldViolation struct {
+	// 필드 경로. e.g., "query", "requests[1].limit"
+	Field string
+	// 잘못된 이유.
+	Description string
+}
+
+// 잘못된 요청 필드를 담은 오류. errdetails.BadRequest 상세 정보로 전달됩니다.
+type InvalidArgumentError struct {
+	Violations []FieldViolation
+}
+
+// 잘못된 필드 하나로 InvalidArgumentError를 만듭니다.
+func InvalidArgument(field, description string) error {
+	return &InvalidArgumentError{Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+func (e *InvalidArgumentError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		if v.Field == "" {
+			msgs = append(msgs, v.Description)
+			continue
+		}
+		msgs = append(msgs, v.Field+": "+v.Description)
+	}
+	return "invalid argument: " + strings.Join(msgs, ", ")
+}
+
+func (e *InvalidArgumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+func (e *InvalidArgumentError) GRPCStatus() *status.Status {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	return withDetails(status.New(codes.InvalidArgument, e.Error()), &errdetails.BadRequest{FieldViolations: violations})
+}
+
+// 외부 서비스에 연결할 수 없을 때의 오류. RetryDelay가 있으면 errdetails.RetryInfo 상세 정보로 전달됩니다.
+type UnavailableError struct {
+	// 연결할 수 없는 서비스. e.g., "qdrant", "embedder"
+	Service string
+	// 다시 시도하기 전에 기다릴 시간. 0이면 알 수 없습니다.
+	RetryDelay time.Duration
+	// 원인 오류. 클라이언트에서 변환한 오류는 서버의 오류 메시지를 담습니다.
+	Err error
+}
+
+func (e *UnavailableError) Error() string {
+	if e.Err == nil {
+		return e.Service + " unavailable"
+	}
+	return fmt.Sprintf("%s unavailable: %v", e.Service, e.Err)
+}
+
+func (e *UnavailableError) Is(target error) bool {
+	return target == ErrUnavailable
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+func (e *UnavailableError) GRPCStatus() *status.Status {
+	st := status.New(codes.Unavailable, e.Error())
+	if e.RetryDelay <= 0 {
+		return st
+	}
+	return withDetails(st, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryDelay)})
+}
+
+// 요청한 리소스가 없을 때의 오류. 리소스마다 errdetails.ResourceInfo 상세 정보로 전달됩니다.
+type NotFoundError struct {
+	// 리소스 종류. e.g., "issue"
+	ResourceType string
+	// 찾지 못한 리소스 이름 목록.
+	Names []string
+}
+
+func (e *NotFoundError) Error() string {
+	if len(e.Names) == 0 {
+		return "not found"
+	}
+	return fmt.Sprintf("%d %s(s) not found: %s", len(e.Names), e.ResourceType, strings.Join(e.Names, ", "))
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+func (e *NotFoundError) GRPCStatus() *status.Status {
+	details := make([]protoadapt.MessageV1, 0, len(e.Names))
+	for _, name := range e.Names {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: e.ResourceType,
+			ResourceName: name,
+			Description:  ErrNotFound.Error(),
+		})
+	}
+	return withDetails(status.New(codes.NotFound, e.Error()), details...)
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		slog.Warn("failed to attach error details", slog.Any("error", err))
+		return st
+	}
+	return withDetails
+}
+
+// 서비스 오류를 gRPC 상태 오류로 바꿉니다.
+// 상태를 가진 오류는 그대로, 오류 종류만 있는 오류는 해당 상태 코드로, 나머지는 Internal로 반환합니다.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// gRPC 상태 오류를 InvalidArgumentError, UnavailableError, NotFoundError로 바꿉니다.
+// 다른 상태 코드의 오류는 그대로 반환합니다.
+func FromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		e := &InvalidArgumentError{}
+		for _, detail := range st.Details() {
+			if d, ok := detail.(*errdetails.BadRequest); ok {
+				for _, v := range d.FieldViolations {
+					e.Violations = append(e.Violations, FieldViolation{Field: v.Field, Description: v.Description})
+				}
+			}
+		}
+		if len(e.Violations) == 0 {
+			e.Violations = []FieldViolation{{Description: st.Message()}}
+		}
+		return e
+	case codes.Unavailable:
+		e := &UnavailableError{Service: "retrieval service", Err: errors.New(st.Message())}
+		for _, detail := range st.Details() {
+			if d, ok := detail.(*errdetails.RetryInfo); ok {
+				e.RetryDelay = d.RetryDelay.AsDuration()
+			}
+		}
+		return e
+	case codes.NotFound:
+		e := &NotFoundError{}
+		for _, detail := range st.Details() {
+			if d, ok := detail.(*errdetails.ResourceInfo); ok {
+				e.ResourceType = d.ResourceType
+				e.Names = append(e.Names, d.ResourceName)
+			}
+		}
+		return e
+	default:
+		return err
+	}
+}
@@ -0,0 +1,141 @@
+package retrieval_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+)
+
+func TestToStatus(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		err      error
+		expected codes.Code
+	}{
+		{
+			desc:     "invalid argument error",
+			err:      retrieval.InvalidArgument("query", "must not be empty"),
+			expected: codes.InvalidArgument,
+		},
+		{
+			desc:     "wrapped unavailable error",
+			err:      fmt.Errorf("failed to embed query: %w", &retrieval.UnavailableError{Service: "embedder"}),
+			expected: codes.Unavailable,
+		},
+		{
+			desc:     "not found error",
+			err:      &retrieval.NotFoundError{ResourceType: "issue", Names: []string{"AA-1"}},
+			expected: codes.NotFound,
+		},
+		{
+			desc:     "sentinel error",
+			err:      fmt.Errorf("%w: collection is missing", retrieval.ErrNotFound),
+			expected: codes.NotFound,
+		},
+		{
+			desc:     "status error",
+			err:      status.Error(codes.PermissionDenied, "denied"),
+			expected: codes.PermissionDenied,
+		},
+		{
+			desc:     "deadline exceeded",
+			err:      fmt.Errorf("failed to search: %w", context.DeadlineExceeded),
+			expected: codes.DeadlineExceeded,
+		},
+		{
+			desc:     "unknown error",
+			err:      errors.New("boom"),
+			expected: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := status.Code(retrieval.ToStatus(tc.err)); got != tc.expected {
+				t.Errorf("expected code %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromStatus(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		err      error
+		sentinel error
+		expected error
+	}{
+		{
+			desc: "field violations",
+			err: &retrieval.InvalidArgumentError{Violations: []retrieval.FieldViolation{
+				{Field: "query", Description: "must not be empty"},
+				{Field: "limit", Description: "must be positive"},
+			}},
+			sentinel: retrieval.ErrInvalidArgument,
+			expected: &retrieval.InvalidArgumentError{Violations: []retrieval.FieldViolation{
+				{Field: "query", Description: "must not be empty"},
+				{Field: "limit", Description: "must be positive"},
+			}},
+		},
+		{
+			desc:     "invalid argument without details",
+			err:      status.Error(codes.InvalidArgument, "bad request"),
+			sentinel: retrieval.ErrInvalidArgument,
+			expected: &retrieval.InvalidArgumentError{Violations: []retrieval.FieldViolation{
+				{Description: "bad request"},
+			}},
+		},
+		{
+			desc:     "retry delay",
+			err:      &retrieval.UnavailableError{Service: "qdrant", RetryDelay: 3 * time.Second},
+			sentinel: retrieval.ErrUnavailable,
+			expected: &retrieval.UnavailableError{
+				Service:    "retrieval service",
+				RetryDelay: 3 * time.Second,
+				Err:        errors.New("qdrant unavailable"),
+			},
+		},
+		{
+			desc:     "missing resources",
+			err:      &retrieval.NotFoundError{ResourceType: "issue", Names: []string{"AA-1", "AA-2"}},
+			sentinel: retrieval.ErrNotFound,
+			expected: &retrieval.NotFoundError{ResourceType: "issue", Names: []string{"AA-1", "AA-2"}},
+		},
+		{
+			desc:     "other codes",
+			err:      status.Error(codes.PermissionDenied, "denied"),
+			expected: status.Error(codes.PermissionDenied, "denied"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// 서버가 보낸 상태를 클라이언트에서 받은 것처럼 직렬화한 상태로 바꾼다.
+			st := status.Convert(retrieval.ToStatus(tc.err))
+			received := status.FromProto(st.Proto()).Err()
+
+			got := retrieval.FromStatus(received)
+			if tc.sentinel != nil && !errors.Is(got, tc.sentinel) {
+				t.Errorf("expected error to be %v, got %v", tc.sentinel, got)
+			}
+			if status.Code(got) != st.Code() {
+				t.Errorf("expected code %v, got %v", st.Code(), status.Code(got))
+			}
+			if tc.sentinel == nil {
+				if got.Error() != tc.expected.Error() {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+				}
+			} else if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package fake
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// 가짜 서버의 호스트 이름. 실제로 조회하지 않습니다.
+	Host = "bufnet"
+
+	bufferSize = 1024 * 1024
+)
+
+// 메모리 안에서 연결을 주고받는 리스너.
+type Listener struct {
+	*bufconn.Listener
+}
+
+func NewListener() *Listener {
+	return &Listener{Listener: bufconn.Listen(bufferSize)}
+}
+
+// 클라이언트가 Host로 이 리스너에 연결하도록 하는 gRPC 연결 옵션을 반환합니다.
+func (l *Listener) DialOptions() []grpc.DialOption {
+	// 클라이언트는 단일 호스트를 "dns" 스킴으로 찾으므로, 이 연결에서만 "dns" 리졸버를 바꿔 실제 조회를 막는다.
+	r := manual.NewBuilderWithScheme("dns")
+	r.InitialState(resolver.State{Addresses: []resolver.Address{{Addr: Host}}})
+
+	return []grpc.DialOption{
+		grpc.WithResolvers(r),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return l.DialContext(ctx)
+		}),
+	}
+}
+
+// serve를 새 고루틴에서 실행하고, 테스트가 끝나면 ctx를 취소해서 종료를 기다립니다.
+func Serve(t testing.TB, serve func(ctx context.Context) error) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- serve(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("failed to serve fake server: %v", err)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package fake
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 가짜 서비스의 응답 지연과 오류를 설정합니다. 모든 메서드는 동시에 호출해도 안전합니다.
+type Faults struct {
+	mu sync.Mutex
+	// 응답하기 전에 기다릴 시간.
+	latency time.Duration
+	// 다음 호출들이 차례로 반환할 오류.
+	next []error
+	// 모든 호출이 반환할 오류.
+	err error
+}
+
+// 모든 호출이 응답하기 전에 d만큼 기다리게 합니다. 호출의 기한이 먼저 지나면 기한 오류를 반환합니다.
+func (f *Faults) SetLatency(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latency = d
+}
+
+// 모든 호출이 err를 반환하게 합니다. nil이면 정상 응답으로 되돌립니다.
+func (f *Faults) SetError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// 다음 호출들이 errs를 차례로 반환하게 합니다. nil인 오류는 정상 응답입니다.
+func (f *Faults) FailNext(errs ...error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next = append(f.next, errs...)
+}
+
+// 설정한 시간만큼 기다린 뒤 이번 호출이 반환할 오류를 반환합니다.
+func (f *Faults) Inject(ctx context.Context) error {
+	f.mu.Lock()
+	latency, err := f.latency, f.err
+	if len(f.next) > 0 {
+		err, f.next = f.next[0], f.next[1:]
+	}
+	f.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return err
+}
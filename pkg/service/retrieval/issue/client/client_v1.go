@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
 )
 
 // RetrieveIssuesV1은 주어진 이슈 키 목록에 대한 이슈를 검색합니다.
@@ -13,7 +14,25 @@ func (c *Client) RetrievalIssuesV1(ctx context.Context, keys []string) ([]*issue
 	}
 	resp, err := c.serviceV1.Retrieve(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, retrieval.FromStatus(err)
 	}
 	return resp.Issues, nil
 }
+
+// SearchIssuesV1은 검색어가 포함된 이슈를 관련도 순서로 검색합니다.
+func (c *Client) SearchIssuesV1(ctx context.Context, req *issue.SearchRequest) (*issue.SearchResponse, error) {
+	resp, err := c.serviceV1.Search(ctx, req)
+	if err != nil {
+		return nil, retrieval.FromStatus(err)
+	}
+	return resp, nil
+}
+
+// ListIssuesV1은 조건에 맞는 이슈를 최근 수정한 순서로 조회합니다.
+func (c *Client) ListIssuesV1(ctx context.Context, req *issue.ListRequest) (*issue.ListResponse, error) {
+	resp, err := c.serviceV1.List(ctx, req)
+	if err != nil {
+		return nil, retrieval.FromStatus(err)
+	}
+	return resp, nil
+}
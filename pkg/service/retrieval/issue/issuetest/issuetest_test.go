@@ -0,0 +1,179 @@
+package issuetest_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/issue/client"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/issue/issuetest"
+)
+
+var issues = []*issue.Issue{
+	{Key: "AA-1", Title: "Login fails", Status: "Open", Labels: []string{"bug"}},
+	{Key: "AA-2", Title: "Add dark mode", Status: "Done"},
+	{Key: "BB-1", Title: "Login page layout", Status: "open", Labels: []string{"ui"}},
+}
+
+func keys(issues []*issue.Issue) []string {
+	keys := make([]string, 0, len(issues))
+	for _, i := range issues {
+		keys = append(keys, i.Key)
+	}
+	return keys
+}
+
+func TestRetrieve(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		keys     []string
+		expected []string
+		missing  []string
+	}{
+		{
+			desc:     "stored issues",
+			keys:     []string{"BB-1", "AA-1"},
+			expected: []string{"BB-1", "AA-1"},
+		},
+		{
+			desc:    "missing issues",
+			keys:    []string{"AA-1", "CC-1", "CC-2"},
+			missing: []string{"CC-1", "CC-2"},
+		},
+	}
+
+	c, s := issuetest.NewClient(t)
+	s.AddIssues(issues...)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := c.RetrievalIssuesV1(context.Background(), tc.keys)
+			if tc.missing != nil {
+				var notFound *retrieval.NotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("expected not found error, got %v", err)
+				}
+				if !reflect.DeepEqual(notFound.Names, tc.missing) {
+					t.Errorf("expected %v, got %v", tc.missing, notFound.Names)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(keys(got), tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, keys(got))
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		setup    func(s *issuetest.Server)
+		req      *issue.SearchRequest
+		expected []string
+		next     string
+		total    int32
+		code     codes.Code
+	}{
+		{
+			desc:     "match title",
+			req:      &issue.SearchRequest{Query: "login"},
+			expected: []string{"AA-1", "BB-1"},
+			total:    2,
+		},
+		{
+			desc: "filter by project and status",
+			req: &issue.SearchRequest{
+				Query:  "login",
+				Filter: &issue.IssueFilter{Projects: []string{"BB"}, Statuses: []string{"OPEN"}},
+			},
+			expected: []string{"BB-1"},
+			total:    1,
+		},
+		{
+			desc: "scripted results",
+			setup: func(s *issuetest.Server) {
+				s.SetSearchResults("anything", issues[2], issues[1])
+			},
+			req:      &issue.SearchRequest{Query: "anything"},
+			expected: []string{"BB-1", "AA-2"},
+			total:    2,
+		},
+		{
+			desc:     "first page",
+			req:      &issue.SearchRequest{Query: "", PageSize: 2},
+			expected: []string{"AA-1", "AA-2"},
+			next:     "2",
+			total:    3,
+		},
+		{
+			desc:     "last page",
+			req:      &issue.SearchRequest{Query: "", PageSize: 2, PageToken: "2"},
+			expected: []string{"BB-1"},
+			total:    3,
+		},
+		{
+			desc: "malformed page token",
+			req:  &issue.SearchRequest{Query: "login", PageToken: "abc"},
+			code: codes.InvalidArgument,
+		},
+		{
+			desc: "injected error",
+			setup: func(s *issuetest.Server) {
+				s.SetError(status.Error(codes.PermissionDenied, "denied"))
+			},
+			req:  &issue.SearchRequest{Query: "login"},
+			code: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, s := issuetest.NewClient(t)
+			s.AddIssues(issues...)
+			if tc.setup != nil {
+				tc.setup(s)
+			}
+
+			resp, err := c.SearchIssuesV1(context.Background(), tc.req)
+			if got := status.Code(err); got != tc.code {
+				t.Fatalf("expected code %v, got %v (%v)", tc.code, got, err)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(keys(resp.Issues), tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, keys(resp.Issues))
+			}
+			if resp.NextPageToken != tc.next {
+				t.Errorf("expected next page token %q, got %q", tc.next, resp.NextPageToken)
+			}
+			if resp.TotalSize != tc.total {
+				t.Errorf("expected total %d, got %d", tc.total, resp.TotalSize)
+			}
+		})
+	}
+}
+
+func TestLatency(t *testing.T) {
+	c, s := issuetest.NewClient(t, client.WithTimeout(50*time.Millisecond))
+	s.SetLatency(time.Second)
+
+	_, err := c.ListIssuesV1(context.Background(), &issue.ListRequest{})
+	if got := status.Code(err); got != codes.DeadlineExceeded {
+		t.Errorf("expected code %v, got %v (%v)", codes.DeadlineExceeded, got, err)
+	}
+	if got := s.Requests(); len(got) != 1 || got[0].Method != "List" {
+		t.Errorf("expected one List request, got %+v", got)
+	}
+}
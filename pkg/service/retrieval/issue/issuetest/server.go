@@ -0,0 +1,59 @@
+package issuetest
+
+import (
+	"testing"
+
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/internal/fake"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/issue/client"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/issue/server"
+)
+
+// bufconn으로 요청을 받는 가짜 이슈 검색 서버. 모든 요청을 Service로 처리합니다.
+type Server struct {
+	*Service
+
+	listener *fake.Listener
+}
+
+// 가짜 서버를 시작합니다. 서버는 테스트가 끝나면 종료됩니다.
+// opts로 인증, 인터셉터 같은 서버 옵션을 추가할 수 있습니다.
+func NewServer(t testing.TB, opts ...server.Option) *Server {
+	t.Helper()
+
+	s := &Server{
+		Service:  NewService(),
+		listener: fake.NewListener(),
+	}
+	opts = append([]server.Option{
+		server.WithListener(s.listener),
+		server.WithServiceV1(s.Service),
+	}, opts...)
+	fake.Serve(t, server.NewServer(opts...).Serve)
+
+	return s
+}
+
+// 이 서버에 연결한 클라이언트를 반환합니다. 클라이언트는 테스트가 끝나면 닫힙니다.
+func (s *Server) NewClient(t testing.TB, opts ...client.Option) *client.Client {
+	t.Helper()
+
+	opts = append([]client.Option{
+		client.WithHost(fake.Host),
+		client.WithDialOptions(s.listener.DialOptions()...),
+	}, opts...)
+	c, err := client.NewClient(opts...)
+	if err != nil {
+		t.Fatalf("failed to create issue client: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return c
+}
+
+// 가짜 서버를 시작하고 연결한 클라이언트와 서버를 반환합니다.
+func NewClient(t testing.TB, opts ...client.Option) (*client.Client, *Server) {
+	t.Helper()
+
+	s := NewServer(t)
+	return s.NewClient(t, opts...), s
+}
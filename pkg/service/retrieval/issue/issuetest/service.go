@@ -0,0 +1,221 @@
+package issuetest
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/internal/fake"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/issue/server"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var _ server.ServiceV1 = (*Service)(nil)
+
+// 가짜 서비스가 받은 요청.
+type Request struct {
+	// 호출한 API. "Retrieve", "Search", "List" 중 하나입니다.
+	Method string
+	// Retrieve의 이슈 키 목록.
+	Keys []string
+	// Search의 검색어.
+	Query  string
+	Filter *issue.IssueFilter
+	Page   server.Page
+}
+
+// 저장한 이슈를 조회하는 메모리 이슈 검색 서비스.
+// Search는 SetSearchResults로 정한 결과를, 정하지 않은 검색어는 제목이나 내용에 검색어가 들어 있는 이슈를 반환합니다.
+// Search와 List는 필터 조건을 적용하고 이슈 키 순서로 페이지를 나누며, 페이지 토큰은 다음 페이지의 시작 위치입니다.
+// SetLatency, SetError, FailNext로 모든 호출의 지연과 오류를 설정합니다.
+type Service struct {
+	fake.Faults
+
+	mu       sync.Mutex
+	issues   map[string]*issue.Issue
+	results  map[string][]*issue.Issue
+	requests []Request
+}
+
+func NewService() *Service {
+	return &Service{
+		issues:  make(map[string]*issue.Issue),
+		results: make(map[string][]*issue.Issue),
+	}
+}
+
+// 이슈를 저장합니다. 키가 같은 이슈는 덮어씁니다.
+func (s *Service) AddIssues(issues ...*issue.Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, i := range issues {
+		s.issues[i.Key] = i
+	}
+}
+
+// Search가 query에 대해 반환할 이슈를 순서대로 정합니다. 필터와 페이지는 이 결과에 적용합니다.
+func (s *Service) SetSearchResults(query string, issues ...*issue.Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[query] = issues
+}
+
+// 지금까지 받은 요청을 받은 순서로 반환합니다.
+func (s *Service) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// 없는 이슈가 있으면 retrieval.NotFoundError를 반환합니다.
+func (s *Service) Retrieve(ctx context.Context, keys []string) ([]*issue.Issue, error) {
+	if err := s.handle(ctx, Request{Method: "Retrieve", Keys: keys}); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	issues := make([]*issue.Issue, 0, len(keys))
+	var missing []string
+	for _, key := range keys {
+		i, ok := s.issues[key]
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		issues = append(issues, i)
+	}
+	if len(missing) > 0 {
+		return nil, &retrieval.NotFoundError{ResourceType: "issue", Names: missing}
+	}
+	return issues, nil
+}
+
+func (s *Service) Search(ctx context.Context, query string, filter *issue.IssueFilter, page server.Page) ([]*issue.Issue, string, int, error) {
+	if err := s.handle(ctx, Request{Method: "Search", Query: query, Filter: filter, Page: page}); err != nil {
+		return nil, "", 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	issues, ok := s.results[query]
+	if !ok {
+		q := strings.ToLower(query)
+		for _, i := range s.sorted() {
+			if strings.Contains(strings.ToLower(i.Title), q) || strings.Contains(strings.ToLower(i.Content), q) {
+				issues = append(issues, i)
+			}
+		}
+	}
+	return paginate(filtered(issues, filter), page)
+}
+
+func (s *Service) List(ctx context.Context, filter *issue.IssueFilter, page server.Page) ([]*issue.Issue, string, int, error) {
+	if err := s.handle(ctx, Request{Method: "List", Filter: filter, Page: page}); err != nil {
+		return nil, "", 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return paginate(filtered(s.sorted(), filter), page)
+}
+
+// 요청을 기록하고 설정한 지연과 오류를 적용합니다.
+func (s *Service) handle(ctx context.Context, req Request) error {
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+
+	return s.Inject(ctx)
+}
+
+// 저장한 이슈를 키 순서로 반환합니다. s.mu를 잡고 호출해야 합니다.
+func (s *Service) sorted() []*issue.Issue {
+	issues := make([]*issue.Issue, 0, len(s.issues))
+	for _, i := range s.issues {
+		issues = append(issues, i)
+	}
+	slices.SortFunc(issues, func(a, b *issue.Issue) int { return strings.Compare(a.Key, b.Key) })
+	return issues
+}
+
+func filtered(issues []*issue.Issue, filter *issue.IssueFilter) []*issue.Issue {
+	if filter == nil {
+		return issues
+	}
+
+	result := make([]*issue.Issue, 0, len(issues))
+	for _, i := range issues {
+		if matches(i, filter) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func matches(i *issue.Issue, filter *issue.IssueFilter) bool {
+	project, _, _ := strings.Cut(i.Key, "-")
+	switch {
+	case len(filter.Projects) > 0 && !slices.Contains(filter.Projects, project):
+		return false
+	case len(filter.Statuses) > 0 && !slices.ContainsFunc(filter.Statuses, func(s string) bool { return strings.EqualFold(s, i.Status) }):
+		return false
+	case len(filter.Labels) > 0 && !slices.ContainsFunc(filter.Labels, func(l string) bool { return slices.Contains(i.Labels, l) }):
+		return false
+	case len(filter.Assignees) > 0 && !slices.Contains(filter.Assignees, i.Assignee):
+		return false
+	}
+	return inRange(i.Created.AsTime(), filter.Created) && inRange(i.Updated.AsTime(), filter.Updated)
+}
+
+func inRange(t time.Time, r *issue.TimeRange) bool {
+	if r == nil {
+		return true
+	}
+	if r.Start != nil && t.Before(r.Start.AsTime()) {
+		return false
+	}
+	if r.End != nil && !t.Before(r.End.AsTime()) {
+		return false
+	}
+	return true
+}
+
+func paginate(issues []*issue.Issue, page server.Page) ([]*issue.Issue, string, int, error) {
+	if page.Size < 0 {
+		return nil, "", 0, retrieval.InvalidArgument("page_size", "must not be negative")
+	}
+	size := page.Size
+	if size == 0 {
+		size = defaultPageSize
+	}
+	size = min(size, maxPageSize)
+
+	offset := 0
+	if page.Token != "" {
+		var err error
+		offset, err = strconv.Atoi(page.Token)
+		if err != nil || offset < 0 || offset > len(issues) {
+			return nil, "", 0, retrieval.InvalidArgument("page_token", "malformed page token")
+		}
+	}
+
+	end := min(offset+size, len(issues))
+	next := ""
+	if end < len(issues) {
+		next = strconv.Itoa(end)
+	}
+	return issues[offset:end], next, len(issues), nil
+}
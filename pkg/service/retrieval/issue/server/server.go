@@ -4,10 +4,19 @@ import (
 	"context"
 	"log/slog"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 
 	issuev1 "github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/credential"
+	"github.com/devafterdark/project-lumos/pkg/service/healthcheck"
+	"github.com/devafterdark/project-lumos/pkg/service/interceptor"
 )
 
 type Server struct {
@@ -25,24 +34,112 @@ func NewServer(opts ...Option) *Server {
 }
 
 func (s *Server) Serve(ctx context.Context) error {
-	listener, err := net.Listen("tcp", net.JoinHostPort("", s.options.port))
+	// 클라이언트가 keepalive ping을 10초 간격까지 보낼 수 있도록 허용한다.
+	opts := append(s.interceptors(), grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             10 * time.Second,
+		PermitWithoutStream: true,
+	}))
+	if s.options.certFile != "" {
+		cfg, err := credential.ServerTLSConfig(s.options.certFile, s.options.keyFile, s.options.clientCAFile)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(cfg)))
+	}
+
+	listener, err := s.listen()
 	if err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(opts...)
+	var services []string
 	if s.options.serviceV1 != nil {
 		issuev1.RegisterIssueRetrievalServiceServer(grpcServer, &serverV1{
 			service: s.options.serviceV1,
 		})
+		services = append(services, issuev1.IssueRetrievalService_ServiceDesc.ServiceName)
 	} else {
 		slog.Warn("service v1 is not set, skipping registration of v1 service")
 	}
 
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if s.options.reflection {
+		reflection.Register(grpcServer)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	checker := healthcheck.NewChecker(healthServer, services, s.options.healthChecks, s.options.healthCheckInterval)
+	go checker.Run(ctx)
+
+	stopped := make(chan struct{})
 	go func() {
+		defer close(stopped)
 		<-ctx.Done()
+		healthServer.Shutdown()
+		s.stop(grpcServer)
+	}()
+
+	err = grpcServer.Serve(listener)
+	cancel()
+	<-stopped
+	return err
+}
+
+// 요청 ID, 로그, 집계, panic 복구, 토큰 확인 순서로 기본 인터셉터를 실행하고, 그 다음에 추가한 인터셉터를 실행합니다.
+func (s *Server) interceptors() []grpc.ServerOption {
+	unary := []grpc.UnaryServerInterceptor{
+		interceptor.UnaryRequestID(),
+		interceptor.UnaryLogging(),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		interceptor.StreamRequestID(),
+		interceptor.StreamLogging(),
+	}
+	// panic도 Internal로 집계되도록 복구보다 먼저 실행한다.
+	if s.options.metrics != nil {
+		unary = append(unary, s.options.metrics.UnaryServerInterceptor())
+		stream = append(stream, s.options.metrics.StreamServerInterceptor())
+	}
+	unary = append(unary, interceptor.UnaryRecovery())
+	stream = append(stream, interceptor.StreamRecovery())
+	if len(s.options.tokens) > 0 {
+		unary = append(unary, interceptor.UnaryAuth(s.options.tokens))
+		stream = append(stream, interceptor.StreamAuth(s.options.tokens))
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(append(unary, s.options.unaryInterceptors...)...),
+		grpc.ChainStreamInterceptor(append(stream, s.options.streamInterceptors...)...),
+	}
+}
+
+func (s *Server) listen() (net.Listener, error) {
+	if s.options.listener != nil {
+		return s.options.listener, nil
+	}
+	return net.Listen("tcp", net.JoinHostPort("", s.options.port))
+}
+
+// 진행 중인 요청이 끝나기를 기다리고, shutdownTimeout이 지나면 남은 요청을 끊고 종료합니다.
+func (s *Server) stop(grpcServer *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
 		grpcServer.GracefulStop()
+		close(done)
 	}()
 
-	return grpcServer.Serve(listener)
+	timer := time.NewTimer(s.options.shutdownTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		slog.Warn("graceful shutdown timed out, forcing stop", "timeout", s.options.shutdownTimeout)
+		grpcServer.Stop()
+		<-done
+	}
 }
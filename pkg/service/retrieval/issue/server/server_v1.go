@@ -4,10 +4,23 @@ import (
 	"context"
 
 	"github.com/devafterdark/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
 )
 
 type ServiceV1 interface {
 	Retrieve(ctx context.Context, keys []string) ([]*issue.Issue, error)
+	// 검색어와 조건에 맞는 이슈의 한 페이지와 다음 페이지 토큰, 전체 이슈 수를 반환합니다.
+	Search(ctx context.Context, query string, filter *issue.IssueFilter, page Page) ([]*issue.Issue, string, int, error)
+	// 조건에 맞는 이슈의 한 페이지와 다음 페이지 토큰, 전체 이슈 수를 반환합니다.
+	List(ctx context.Context, filter *issue.IssueFilter, page Page) ([]*issue.Issue, string, int, error)
+}
+
+// 페이지 요청.
+type Page struct {
+	// 한 페이지의 최대 이슈 수. 0이면 서비스 기본값을 사용합니다.
+	Size int
+	// 이전 응답의 다음 페이지 토큰. 비어 있으면 첫 페이지입니다.
+	Token string
 }
 
 type serverV1 struct {
@@ -19,7 +32,25 @@ type serverV1 struct {
 func (s *serverV1) Retrieve(ctx context.Context, req *issue.RetrieveRequest) (*issue.RetrieveResponse, error) {
 	issues, err := s.service.Retrieve(ctx, req.IssueKeys)
 	if err != nil {
-		return nil, err
+		return nil, retrieval.ToStatus(err)
 	}
 	return &issue.RetrieveResponse{Issues: issues}, nil
 }
+
+func (s *serverV1) Search(ctx context.Context, req *issue.SearchRequest) (*issue.SearchResponse, error) {
+	page := Page{Size: int(req.PageSize), Token: req.PageToken}
+	issues, next, total, err := s.service.Search(ctx, req.Query, req.Filter, page)
+	if err != nil {
+		return nil, retrieval.ToStatus(err)
+	}
+	return &issue.SearchResponse{Issues: issues, NextPageToken: next, TotalSize: int32(total)}, nil
+}
+
+func (s *serverV1) List(ctx context.Context, req *issue.ListRequest) (*issue.ListResponse, error) {
+	page := Page{Size: int(req.PageSize), Token: req.PageToken}
+	issues, next, total, err := s.service.List(ctx, req.Filter, page)
+	if err != nil {
+		return nil, retrieval.ToStatus(err)
+	}
+	return &issue.ListResponse{Issues: issues, NextPageToken: next, TotalSize: int32(total)}, nil
+}
@@ -1,12 +1,14 @@
 package client
 
 import (
-	"net"
-
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	passagev1 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/credential"
+	"github.com/devafterdark/project-lumos/pkg/service/dialer"
 )
 
 // Client는 패시지 검색 서비스를 위한 클라이언트 API입니다.
@@ -15,6 +17,7 @@ type Client struct {
 
 	grpcClient *grpc.ClientConn
 	serviceV1  passagev1.PassageRetrievalServiceClient
+	serviceV2  passagev2.PassageRetrievalServiceClient
 }
 
 // NewClient는 새로운 패시지 검색 서비스 클라이언트를 생성합니다.
@@ -24,10 +27,13 @@ func NewClient(opts ...Option) (*Client, error) {
 		opt(&options)
 	}
 
-	grpcClient, err := grpc.NewClient(
-		net.JoinHostPort(options.host, options.port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	dialOpts, err := options.dialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	target, resolverOpts := dialer.Target(options.hosts, options.port)
+	grpcClient, err := grpc.NewClient(target, append(resolverOpts, dialOpts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +42,7 @@ func NewClient(opts ...Option) (*Client, error) {
 		options:    &options,
 		grpcClient: grpcClient,
 		serviceV1:  passagev1.NewPassageRetrievalServiceClient(grpcClient),
+		serviceV2:  passagev2.NewPassageRetrievalServiceClient(grpcClient),
 	}, nil
 }
 
@@ -43,3 +50,36 @@ func NewClient(opts ...Option) (*Client, error) {
 func (c *Client) Close() error {
 	return c.grpcClient.Close()
 }
+
+func (o *clientOptions) dialOptions() ([]grpc.DialOption, error) {
+	creds := insecure.NewCredentials()
+	if o.tls {
+		cfg, err := credential.ClientTLSConfig(o.caFile, o.certFile, o.keyFile)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(cfg)
+	}
+
+	serviceConfig, err := dialer.ServiceConfig([]string{
+		passagev1.PassageRetrievalService_ServiceDesc.ServiceName,
+		passagev2.PassageRetrievalService_ServiceDesc.ServiceName,
+	}, o.retry)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(dialer.UnaryTimeout(o.timeout)),
+		grpc.WithChainStreamInterceptor(dialer.StreamTimeout(o.timeout)),
+	}
+	if o.token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(credential.NewTokenCredentials(o.token, o.tls)))
+	}
+	if o.keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*o.keepalive))
+	}
+	return append(opts, o.extraDialOptions...), nil
+}
@@ -2,19 +2,72 @@ package client
 
 import (
 	"context"
+	"errors"
+	"io"
+	"iter"
 
 	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
 )
 
 // RetrievePassagesV1은 주어진 쿼리를 기반으로 최대 limit 개수만큼 패시지를 검색합니다.
 func (c *Client) RetrievePassagesV1(ctx context.Context, query string, limit int32) ([]*passage.Passage, error) {
+	return c.RetrieveFilteredPassagesV1(ctx, query, limit, nil)
+}
+
+// RetrieveFilteredPassagesV1은 메타데이터 조건을 만족하는 패시지 중에서 주어진 쿼리를 기반으로 최대 limit 개수만큼 검색합니다.
+func (c *Client) RetrieveFilteredPassagesV1(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passage.Filter,
+) ([]*passage.Passage, error) {
 	req := &passage.RetrieveRequest{
-		Query: query,
-		Limit: limit,
+		Query:  query,
+		Limit:  limit,
+		Filter: filter,
 	}
 	resp, err := c.serviceV1.Retrieve(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, retrieval.FromStatus(err)
 	}
 	return resp.Passages, nil
 }
+
+// RetrievePassagesStreamV1은 주어진 쿼리를 기반으로 최대 limit 개수만큼 패시지를 검색해서 점수가 높은 순서로 하나씩 반환합니다.
+// 검색이 모두 끝나기 전에 상위 패시지부터 받을 수 있습니다. 반복을 중간에 멈추면 스트림을 취소합니다.
+// 오류가 발생하면 오류를 반환하고 반복을 끝냅니다.
+func (c *Client) RetrievePassagesStreamV1(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passage.Filter,
+) iter.Seq2[*passage.Passage, error] {
+	return func(yield func(*passage.Passage, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := c.serviceV1.RetrieveStream(ctx, &passage.RetrieveRequest{
+			Query:  query,
+			Limit:  limit,
+			Filter: filter,
+		})
+		if err != nil {
+			yield(nil, retrieval.FromStatus(err))
+			return
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			} else if err != nil {
+				yield(nil, retrieval.FromStatus(err))
+				return
+			}
+			if !yield(resp.Passage, nil) {
+				return
+			}
+		}
+	}
+}
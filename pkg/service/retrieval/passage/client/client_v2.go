@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+
+	"github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+)
+
+// 검색 요청 하나에 적용하는 옵션.
+type RetrieveOption func(*passage.RetrieveRequest)
+
+// 검색 방식을 지정합니다. 지정하지 않으면 서비스 기본 방식으로 검색합니다.
+func WithRetrievalMode(mode passage.RetrievalMode) RetrieveOption {
+	return func(req *passage.RetrieveRequest) {
+		req.Mode = mode
+	}
+}
+
+// RetrievePassagesV2는 메타데이터 조건을 만족하는 패시지 중에서 주어진 쿼리를 기반으로 최대 limit 개수만큼 검색합니다.
+// filter가 nil이면 모든 패시지를 검색합니다.
+func (c *Client) RetrievePassagesV2(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passage.Filter,
+	opts ...RetrieveOption,
+) ([]*passage.Passage, error) {
+	req := &passage.RetrieveRequest{
+		Query:  query,
+		Limit:  limit,
+		Filter: filter,
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+	resp, err := c.serviceV2.Retrieve(ctx, req)
+	if err != nil {
+		return nil, retrieval.FromStatus(err)
+	}
+	return resp.Passages, nil
+}
+
+// BatchRetrievePassagesV2는 여러 쿼리의 패시지를 한 번에 검색해서 요청과 같은 순서로 쿼리별 결과를 반환합니다.
+// 실패한 쿼리는 결과의 Error에 원인이 담기며, 요청 전체가 실패했을 때만 오류를 반환합니다.
+func (c *Client) BatchRetrievePassagesV2(
+	ctx context.Context,
+	requests []*passage.RetrieveRequest,
+) ([]*passage.BatchRetrieveResult, error) {
+	resp, err := c.serviceV2.BatchRetrieve(ctx, &passage.BatchRetrieveRequest{Requests: requests})
+	if err != nil {
+		return nil, retrieval.FromStatus(err)
+	}
+	return resp.Results, nil
+}
@@ -1,20 +1,58 @@
 package client
 
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/devafterdark/project-lumos/pkg/service/dialer"
+)
+
 type clientOptions struct {
-	host string
-	port string
+	// 백엔드 호스트 목록. 하나면 DNS가 반환하는 모든 주소로, 여러 개면 각 호스트로 요청을 나눠 보냅니다.
+	hosts []string
+	port  string
+
+	// 기한이 없는 호출에 적용할 기한. 0이면 기한을 설정하지 않습니다.
+	timeout time.Duration
+	// 재시도 정책. nil이면 재시도하지 않습니다.
+	retry *dialer.RetryPolicy
+	// keepalive 설정. nil이면 keepalive ping을 보내지 않습니다.
+	keepalive *keepalive.ClientParameters
+	// 마지막에 추가할 gRPC 연결 옵션.
+	extraDialOptions []grpc.DialOption
+
+	// TLS 연결 여부.
+	tls bool
+	// 서버 인증서를 확인할 CA 파일. 비어 있으면 시스템 인증서를 사용합니다.
+	caFile string
+	// mTLS에 사용할 클라이언트 인증서와 개인 키 파일.
+	certFile string
+	keyFile  string
+	// 호출마다 보낼 bearer 토큰.
+	token string
 }
 
 var defaultClientOptions = clientOptions{
-	host: "passage-retrieval-service",
-	port: "50051",
+	hosts:   []string{"passage-retrieval-service"},
+	port:    "50051",
+	timeout: 10 * time.Second,
+	retry:   &dialer.DefaultRetryPolicy,
 }
 
 type Option func(*clientOptions)
 
 func WithHost(host string) Option {
 	return func(opt *clientOptions) {
-		opt.host = host
+		opt.hosts = []string{host}
+	}
+}
+
+// 여러 백엔드에 요청을 번갈아 보냅니다. 모든 호스트는 같은 포트를 사용합니다.
+func WithHosts(hosts ...string) Option {
+	return func(opt *clientOptions) {
+		opt.hosts = hosts
 	}
 }
 
@@ -23,3 +61,66 @@ func WithPort(port string) Option {
 		opt.port = port
 	}
 }
+
+// TLS로 연결합니다. caFile이 비어 있으면 시스템 인증서로 서버 인증서를 확인합니다.
+func WithTLS(caFile string) Option {
+	return func(opt *clientOptions) {
+		opt.tls = true
+		opt.caFile = caFile
+	}
+}
+
+// mTLS에 사용할 클라이언트 인증서를 설정합니다. TLS 연결을 함께 사용합니다.
+func WithClientCertificate(certFile, keyFile string) Option {
+	return func(opt *clientOptions) {
+		opt.tls = true
+		opt.certFile = certFile
+		opt.keyFile = keyFile
+	}
+}
+
+// 호출마다 authorization 메타데이터에 bearer 토큰을 보냅니다. TLS를 사용하지 않으면 토큰이 평문으로 전송됩니다.
+func WithToken(token string) Option {
+	return func(opt *clientOptions) {
+		opt.token = token
+	}
+}
+
+// 기한이 없는 호출에 적용할 기한을 설정합니다. 스트림은 스트림 전체에 적용됩니다. 0이면 기한을 설정하지 않습니다.
+func WithTimeout(timeout time.Duration) Option {
+	return func(opt *clientOptions) {
+		opt.timeout = timeout
+	}
+}
+
+// 재시도 정책을 설정합니다. 기본값은 dialer.DefaultRetryPolicy입니다.
+func WithRetryPolicy(policy dialer.RetryPolicy) Option {
+	return func(opt *clientOptions) {
+		opt.retry = &policy
+	}
+}
+
+func WithoutRetry() Option {
+	return func(opt *clientOptions) {
+		opt.retry = nil
+	}
+}
+
+// 요청이 없는 동안 interval마다 ping을 보내고, timeout 안에 응답이 없으면 연결을 끊습니다.
+// 서버는 10초보다 자주 보내는 ping을 거부하므로 interval은 10초 이상이어야 합니다.
+func WithKeepalive(interval, timeout time.Duration) Option {
+	return func(opt *clientOptions) {
+		opt.keepalive = &keepalive.ClientParameters{
+			Time:                interval,
+			Timeout:             timeout,
+			PermitWithoutStream: true,
+		}
+	}
+}
+
+// 다른 옵션으로 설정할 수 없는 gRPC 연결 옵션을 추가합니다. 다른 옵션보다 나중에 적용됩니다.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(opt *clientOptions) {
+		opt.extraDialOptions = append(opt.extraDialOptions, opts...)
+	}
+}
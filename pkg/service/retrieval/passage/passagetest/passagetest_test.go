@@ -0,0 +1,205 @@
+package passagetest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	passagev1 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/client"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/passagetest"
+)
+
+func TestRetrieve(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		setup    func(s *passagetest.Server)
+		opts     []client.Option
+		query    string
+		limit    int32
+		expected []string
+		err      error
+		code     codes.Code
+		requests int
+	}{
+		{
+			desc: "scripted passages",
+			setup: func(s *passagetest.Server) {
+				s.SetPassagesV2("query", &passagev2.Passage{Id: "1"}, &passagev2.Passage{Id: "2"})
+			},
+			query:    "query",
+			expected: []string{"1", "2"},
+			requests: 1,
+		},
+		{
+			desc: "truncate to limit",
+			setup: func(s *passagetest.Server) {
+				s.SetPassagesV2("query", &passagev2.Passage{Id: "1"}, &passagev2.Passage{Id: "2"})
+			},
+			query:    "query",
+			limit:    1,
+			expected: []string{"1"},
+			requests: 1,
+		},
+		{
+			desc:     "unknown query",
+			query:    "unknown",
+			requests: 1,
+		},
+		{
+			desc: "query error",
+			setup: func(s *passagetest.Server) {
+				s.SetQueryError("query", retrieval.InvalidArgument("query", "too long"))
+			},
+			query:    "query",
+			err:      retrieval.ErrInvalidArgument,
+			code:     codes.InvalidArgument,
+			requests: 1,
+		},
+		{
+			desc: "retry injected unavailable error",
+			setup: func(s *passagetest.Server) {
+				s.SetPassagesV2("query", &passagev2.Passage{Id: "1"})
+				s.FailNext(&retrieval.UnavailableError{Service: "qdrant"})
+			},
+			query:    "query",
+			expected: []string{"1"},
+			requests: 2,
+		},
+		{
+			desc: "injected error without retry",
+			setup: func(s *passagetest.Server) {
+				s.FailNext(&retrieval.UnavailableError{Service: "qdrant"})
+			},
+			opts:     []client.Option{client.WithoutRetry()},
+			query:    "query",
+			err:      retrieval.ErrUnavailable,
+			code:     codes.Unavailable,
+			requests: 1,
+		},
+		{
+			desc: "latency exceeds timeout",
+			setup: func(s *passagetest.Server) {
+				s.SetLatency(time.Second)
+			},
+			opts:     []client.Option{client.WithTimeout(50 * time.Millisecond)},
+			query:    "query",
+			code:     codes.DeadlineExceeded,
+			requests: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := passagetest.NewServer(t)
+			if tc.setup != nil {
+				tc.setup(s)
+			}
+			c := s.NewClient(t, tc.opts...)
+
+			passages, err := c.RetrievePassagesV2(context.Background(), tc.query, tc.limit, nil)
+			if got := status.Code(err); got != tc.code {
+				t.Fatalf("expected code %v, got %v (%v)", tc.code, got, err)
+			}
+			if tc.err != nil && !errors.Is(err, tc.err) {
+				t.Errorf("expected error to be %v, got %v", tc.err, err)
+			}
+
+			ids := make([]string, 0, len(passages))
+			for _, p := range passages {
+				ids = append(ids, p.Id)
+			}
+			if len(ids) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, ids)
+			}
+			for i := range ids {
+				if ids[i] != tc.expected[i] {
+					t.Errorf("expected %v, got %v", tc.expected, ids)
+				}
+			}
+			if got := len(s.Requests()); got != tc.requests {
+				t.Errorf("expected %d requests, got %d", tc.requests, got)
+			}
+		})
+	}
+}
+
+func TestRetrieveStream(t *testing.T) {
+	c, s := passagetest.NewClient(t)
+	s.SetPassagesV1("query", &passagev1.Passage{Score: 3}, &passagev1.Passage{Score: 2}, &passagev1.Passage{Score: 1})
+
+	var scores []float32
+	for p, err := range c.RetrievePassagesStreamV1(context.Background(), "query", 2, nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		scores = append(scores, p.Score)
+	}
+	if len(scores) != 2 || scores[0] != 3 || scores[1] != 2 {
+		t.Errorf("expected [3 2], got %v", scores)
+	}
+
+	requests := s.Requests()
+	if len(requests) != 1 || requests[0].Method != "RetrieveStream" || requests[0].Limit != 2 {
+		t.Errorf("expected one RetrieveStream request with limit 2, got %+v", requests)
+	}
+}
+
+func TestBatchRetrieve(t *testing.T) {
+	c, s := passagetest.NewClient(t)
+	s.SetPassagesV2("first", &passagev2.Passage{Id: "1"})
+	s.SetQueryError("second", &retrieval.UnavailableError{Service: "embedder"})
+
+	results, err := c.BatchRetrievePassagesV2(context.Background(), []*passagev2.RetrieveRequest{
+		{Query: "first"},
+		{Query: "second"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if got := len(results[0].Passages); got != 1 || results[0].Error != nil {
+		t.Errorf("expected 1 passage without error, got %d passages and %v", got, results[0].Error)
+	}
+	if got := codes.Code(results[1].GetError().GetCode()); got != codes.Unavailable {
+		t.Errorf("expected code %v, got %v", codes.Unavailable, got)
+	}
+}
+
+func TestRetrievalMode(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		opts     []client.RetrieveOption
+		expected passagev2.RetrievalMode
+	}{
+		{
+			desc:     "service default",
+			expected: passagev2.RetrievalMode_RETRIEVAL_MODE_UNSPECIFIED,
+		},
+		{
+			desc:     "hybrid",
+			opts:     []client.RetrieveOption{client.WithRetrievalMode(passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID)},
+			expected: passagev2.RetrievalMode_RETRIEVAL_MODE_HYBRID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, s := passagetest.NewClient(t)
+			if _, err := c.RetrievePassagesV2(context.Background(), "query", 1, nil, tc.opts...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := s.Requests()[0].Mode; got != tc.expected {
+				t.Errorf("expected mode %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package passagetest
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/status"
+
+	passagev1 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/internal/fake"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval/passage/server"
+)
+
+var (
+	_ server.ServiceV1 = (*Service)(nil)
+	_ server.ServiceV2 = (*Service)(nil)
+)
+
+// 가짜 서비스가 받은 요청.
+type Request struct {
+	// 호출한 API. "Retrieve", "RetrieveStream", "RetrieveV2", "BatchRetrieveV2" 중 하나입니다.
+	Method string
+	Query  string
+	Limit  int32
+	// 요청의 필터. v1 API는 FilterV1, v2 API는 FilterV2에 담깁니다.
+	FilterV1 *passagev1.Filter
+	FilterV2 *passagev2.Filter
+	// v2 요청의 검색 방식.
+	Mode passagev2.RetrievalMode
+}
+
+// 쿼리별로 미리 정한 패시지를 반환하는 메모리 패시지 검색 서비스.
+// 정하지 않은 쿼리는 빈 결과를 반환하고, limit이 양수이면 결과를 limit 개수까지 자릅니다.
+// SetLatency, SetError, FailNext로 모든 호출의 지연과 오류를, SetQueryError로 쿼리별 오류를 설정합니다.
+type Service struct {
+	fake.Faults
+
+	mu         sync.Mutex
+	passagesV1 map[string][]*passagev1.Passage
+	passagesV2 map[string][]*passagev2.Passage
+	queryErrs  map[string]error
+	requests   []Request
+}
+
+func NewService() *Service {
+	return &Service{
+		passagesV1: make(map[string][]*passagev1.Passage),
+		passagesV2: make(map[string][]*passagev2.Passage),
+		queryErrs:  make(map[string]error),
+	}
+}
+
+// v1 API가 query에 대해 반환할 패시지를 정합니다.
+func (s *Service) SetPassagesV1(query string, passages ...*passagev1.Passage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.passagesV1[query] = passages
+}
+
+// v2 API가 query에 대해 반환할 패시지를 정합니다.
+func (s *Service) SetPassagesV2(query string, passages ...*passagev2.Passage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.passagesV2[query] = passages
+}
+
+// query의 검색이 err를 반환하게 합니다. 일괄 검색에서는 해당 결과의 Error에 담깁니다. nil이면 설정을 지웁니다.
+func (s *Service) SetQueryError(query string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.queryErrs, query)
+		return
+	}
+	s.queryErrs[query] = err
+}
+
+// 지금까지 받은 요청을 받은 순서로 반환합니다. 일괄 검색은 쿼리마다 하나씩 기록합니다.
+func (s *Service) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Service) Retrieve(ctx context.Context, query string, limit int32, filter *passagev1.Filter) ([]*passagev1.Passage, error) {
+	if err := s.handle(ctx, Request{Method: "Retrieve", Query: query, Limit: limit, FilterV1: filter}); err != nil {
+		return nil, err
+	}
+	return s.resultV1(query, limit)
+}
+
+func (s *Service) RetrieveStream(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passagev1.Filter,
+	send func(*passagev1.Passage) error,
+) error {
+	if err := s.handle(ctx, Request{Method: "RetrieveStream", Query: query, Limit: limit, FilterV1: filter}); err != nil {
+		return err
+	}
+	passages, err := s.resultV1(query, limit)
+	if err != nil {
+		return err
+	}
+	for _, p := range passages {
+		if err := send(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) RetrieveV2(
+	ctx context.Context,
+	query string,
+	limit int32,
+	filter *passagev2.Filter,
+	mode passagev2.RetrievalMode,
+) ([]*passagev2.Passage, error) {
+	if err := s.handle(ctx, Request{Method: "RetrieveV2", Query: query, Limit: limit, FilterV2: filter, Mode: mode}); err != nil {
+		return nil, err
+	}
+	return s.resultV2(query, limit)
+}
+
+func (s *Service) BatchRetrieveV2(ctx context.Context, requests []*passagev2.RetrieveRequest) ([]*passagev2.BatchRetrieveResult, error) {
+	s.mu.Lock()
+	for _, req := range requests {
+		s.requests = append(s.requests, Request{
+			Method:   "BatchRetrieveV2",
+			Query:    req.GetQuery(),
+			Limit:    req.GetLimit(),
+			FilterV2: req.GetFilter(),
+			Mode:     req.GetMode(),
+		})
+	}
+	s.mu.Unlock()
+
+	if err := s.Inject(ctx); err != nil {
+		return nil, err
+	}
+
+	results := make([]*passagev2.BatchRetrieveResult, 0, len(requests))
+	for _, req := range requests {
+		passages, err := s.resultV2(req.GetQuery(), req.GetLimit())
+		if err != nil {
+			st := status.Convert(retrieval.ToStatus(err))
+			results = append(results, &passagev2.BatchRetrieveResult{
+				Error: &passagev2.Error{Code: int32(st.Code()), Message: st.Message()},
+			})
+			continue
+		}
+		results = append(results, &passagev2.BatchRetrieveResult{Passages: passages})
+	}
+	return results, nil
+}
+
+// 요청을 기록하고 설정한 지연과 오류를 적용합니다.
+func (s *Service) handle(ctx context.Context, req Request) error {
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+
+	return s.Inject(ctx)
+}
+
+func (s *Service) resultV1(query string, limit int32) ([]*passagev1.Passage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.queryErrs[query]; err != nil {
+		return nil, err
+	}
+	return truncate(s.passagesV1[query], limit), nil
+}
+
+func (s *Service) resultV2(query string, limit int32) ([]*passagev2.Passage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.queryErrs[query]; err != nil {
+		return nil, err
+	}
+	return truncate(s.passagesV2[query], limit), nil
+}
+
+func truncate[T any](items []T, limit int32) []T {
+	if limit > 0 && int(limit) < len(items) {
+		items = items[:limit]
+	}
+	return append([]T(nil), items...)
+}
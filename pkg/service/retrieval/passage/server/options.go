@@ -1,13 +1,48 @@
 package server
 
+import (
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/devafterdark/project-lumos/pkg/service/healthcheck"
+	"github.com/devafterdark/project-lumos/pkg/service/interceptor"
+)
+
 type serverOptions struct {
 	port string
+	// 설정하면 port 대신 이 리스너로 요청을 받습니다.
+	listener net.Listener
+	// gRPC 서버 리플렉션 등록 여부.
+	reflection bool
+	// 정상 종료를 기다리는 최대 시간. 지나면 남은 요청을 끊고 종료합니다.
+	shutdownTimeout time.Duration
+
+	// 서버 인증서와 개인 키 파일. 설정하면 TLS로 요청을 받습니다.
+	certFile string
+	keyFile  string
+	// 클라이언트 인증서를 확인할 CA 파일. 설정하면 클라이언트 인증서를 요구합니다. (mTLS)
+	clientCAFile string
+	// 허용하는 bearer 토큰 목록. 비어 있으면 토큰을 확인하지 않습니다.
+	tokens []string
+
+	healthChecks        []healthcheck.NamedCheck
+	healthCheckInterval time.Duration
+
+	// 기본 인터셉터(요청 ID, 로그, 집계, panic 복구, 토큰 확인) 다음에 실행할 인터셉터.
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	metrics            *interceptor.Metrics
 
 	serviceV1 ServiceV1
+	serviceV2 ServiceV2
 }
 
 var defaultServerOptions = serverOptions{
-	port: "50051",
+	port:                "50051",
+	shutdownTimeout:     10 * time.Second,
+	healthCheckInterval: 10 * time.Second,
 }
 
 type Option func(*serverOptions)
@@ -18,8 +53,90 @@ func WithPort(port string) Option {
 	}
 }
 
+// 주어진 리스너로 요청을 받습니다. 설정하면 WithPort는 무시됩니다.
+func WithListener(listener net.Listener) Option {
+	return func(opt *serverOptions) {
+		opt.listener = listener
+	}
+}
+
+// grpcurl 같은 도구가 서비스 정의를 조회할 수 있도록 서버 리플렉션을 등록합니다.
+func WithReflection() Option {
+	return func(opt *serverOptions) {
+		opt.reflection = true
+	}
+}
+
+func WithShutdownTimeout(timeout time.Duration) Option {
+	return func(opt *serverOptions) {
+		opt.shutdownTimeout = timeout
+	}
+}
+
+// 서버 인증서와 개인 키로 TLS 연결을 받습니다.
+func WithTLS(certFile, keyFile string) Option {
+	return func(opt *serverOptions) {
+		opt.certFile = certFile
+		opt.keyFile = keyFile
+	}
+}
+
+// caFile의 CA가 서명한 클라이언트 인증서를 요구합니다. WithTLS와 함께 사용합니다.
+func WithClientCA(caFile string) Option {
+	return func(opt *serverOptions) {
+		opt.clientCAFile = caFile
+	}
+}
+
+// authorization 메타데이터의 bearer 토큰이 tokens 중 하나인 요청만 허용합니다. 헬스 체크와 리플렉션은 예외입니다.
+func WithTokens(tokens ...string) Option {
+	return func(opt *serverOptions) {
+		opt.tokens = append(opt.tokens, tokens...)
+	}
+}
+
+// 헬스 체크 상태에 반영할 의존성 검사를 추가합니다. 검사가 하나라도 실패하면 NOT_SERVING입니다.
+func WithHealthCheck(name string, check healthcheck.Check) Option {
+	return func(opt *serverOptions) {
+		opt.healthChecks = append(opt.healthChecks, healthcheck.NamedCheck{Name: name, Check: check})
+	}
+}
+
+func WithHealthCheckInterval(interval time.Duration) Option {
+	return func(opt *serverOptions) {
+		opt.healthCheckInterval = interval
+	}
+}
+
+// 기본 인터셉터 다음에 순서대로 실행할 단항 호출 인터셉터를 추가합니다.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(opt *serverOptions) {
+		opt.unaryInterceptors = append(opt.unaryInterceptors, interceptors...)
+	}
+}
+
+// 기본 인터셉터 다음에 순서대로 실행할 스트리밍 호출 인터셉터를 추가합니다.
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) Option {
+	return func(opt *serverOptions) {
+		opt.streamInterceptors = append(opt.streamInterceptors, interceptors...)
+	}
+}
+
+// 메서드별 호출 결과와 처리 시간을 metrics에 집계합니다.
+func WithMetrics(metrics *interceptor.Metrics) Option {
+	return func(opt *serverOptions) {
+		opt.metrics = metrics
+	}
+}
+
 func WithServiceV1(s ServiceV1) Option {
 	return func(opt *serverOptions) {
 		opt.serviceV1 = s
 	}
 }
+
+func WithServiceV2(s ServiceV2) Option {
+	return func(opt *serverOptions) {
+		opt.serviceV2 = s
+	}
+}
@@ -3,11 +3,23 @@ package server
 import (
 	"context"
 
+	"google.golang.org/grpc"
+
 	passagev1 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v1"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
 )
 
 type ServiceV1 interface {
-	Retrieve(ctx context.Context, query string, limit int32) ([]*passagev1.Passage, error)
+	// filter가 nil이면 모든 패시지를 검색합니다.
+	Retrieve(ctx context.Context, query string, limit int32, filter *passagev1.Filter) ([]*passagev1.Passage, error)
+	// 검색한 패시지를 점수가 높은 순서로 send에 전달합니다. send가 오류를 반환하면 검색을 멈추고 그 오류를 반환합니다.
+	RetrieveStream(
+		ctx context.Context,
+		query string,
+		limit int32,
+		filter *passagev1.Filter,
+		send func(*passagev1.Passage) error,
+	) error
 }
 
 type serverV1 struct {
@@ -17,9 +29,19 @@ type serverV1 struct {
 }
 
 func (s *serverV1) Retrieve(ctx context.Context, req *passagev1.RetrieveRequest) (*passagev1.RetrieveResponse, error) {
-	passages, err := s.service.Retrieve(ctx, req.Query, req.Limit)
+	passages, err := s.service.Retrieve(ctx, req.Query, req.Limit, req.Filter)
 	if err != nil {
-		return nil, err
+		return nil, retrieval.ToStatus(err)
 	}
 	return &passagev1.RetrieveResponse{Passages: passages}, nil
 }
+
+func (s *serverV1) RetrieveStream(
+	req *passagev1.RetrieveRequest,
+	stream grpc.ServerStreamingServer[passagev1.RetrieveStreamResponse],
+) error {
+	err := s.service.RetrieveStream(stream.Context(), req.Query, req.Limit, req.Filter, func(p *passagev1.Passage) error {
+		return stream.Send(&passagev1.RetrieveStreamResponse{Passage: p})
+	})
+	return retrieval.ToStatus(err)
+}
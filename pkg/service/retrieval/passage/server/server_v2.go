@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	passagev2 "github.com/devafterdark/project-lumos/gen/go/retrieval/passage/v2"
+	"github.com/devafterdark/project-lumos/pkg/service/retrieval"
+)
+
+type ServiceV2 interface {
+	// filter가 nil이면 모든 패시지를 검색합니다. mode가 RETRIEVAL_MODE_UNSPECIFIED이면 서비스 기본 방식으로 검색합니다.
+	RetrieveV2(
+		ctx context.Context,
+		query string,
+		limit int32,
+		filter *passagev2.Filter,
+		mode passagev2.RetrievalMode,
+	) ([]*passagev2.Passage, error)
+	// 요청과 같은 순서로 쿼리별 결과를 반환합니다. 쿼리 하나의 실패는 결과의 Error에 담고, 요청 전체가 실패할 때만 오류를 반환합니다.
+	BatchRetrieveV2(ctx context.Context, requests []*passagev2.RetrieveRequest) ([]*passagev2.BatchRetrieveResult, error)
+}
+
+type serverV2 struct {
+	passagev2.UnimplementedPassageRetrievalServiceServer
+
+	service ServiceV2
+}
+
+func (s *serverV2) Retrieve(ctx context.Context, req *passagev2.RetrieveRequest) (*passagev2.RetrieveResponse, error) {
+	passages, err := s.service.RetrieveV2(ctx, req.Query, req.Limit, req.Filter, req.Mode)
+	if err != nil {
+		return nil, retrieval.ToStatus(err)
+	}
+	return &passagev2.RetrieveResponse{Passages: passages}, nil
+}
+
+func (s *serverV2) BatchRetrieve(ctx context.Context, req *passagev2.BatchRetrieveRequest) (*passagev2.BatchRetrieveResponse, error) {
+	results, err := s.service.BatchRetrieveV2(ctx, req.Requests)
+	if err != nil {
+		return nil, retrieval.ToStatus(err)
+	}
+	return &passagev2.BatchRetrieveResponse{Results: results}, nil
+}
@@ -0,0 +1,43 @@
+package vars
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"net"
+	"net/http"
+	"time"
+)
+
+// 값을 제공하는 경로. expvar 패키지의 기본 경로와 같습니다.
+const Path = "/debug/vars"
+
+// 서버를 종료할 때 처리 중인 요청을 기다리는 최대 시간.
+const shutdownTimeout = 5 * time.Second
+
+// expvar.Publish로 등록한 값을 listener에서 JSON으로 제공합니다. ctx가 끝나면 서버를 종료하고 nil을 반환합니다.
+// gRPC 호출 집계처럼 종료할 때만 로그로 남기던 값을 실행 중에도 확인할 때 사용합니다.
+func Serve(ctx context.Context, listener net.Listener) error {
+	mux := http.NewServeMux()
+	mux.Handle("GET "+Path, expvar.Handler())
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	err := server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		<-stopped
+		return nil
+	}
+	return err
+}
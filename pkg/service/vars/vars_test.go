@@ -0,0 +1,50 @@
+package vars_test
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/devafterdark/project-lumos/pkg/service/vars"
+)
+
+func TestServe(t *testing.T) {
+	counter := expvar.NewInt("vars_test_counter")
+	counter.Add(3)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- vars.Serve(ctx, listener)
+	}()
+
+	resp, err := http.Get("http://" + listener.Addr().String() + vars.Path)
+	if err != nil {
+		t.Fatalf("failed to get vars: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	var got map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode vars: %v", err)
+	}
+	if string(got["vars_test_counter"]) != "3" {
+		t.Errorf("expected counter 3, got %s", got["vars_test_counter"])
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("expected nil after shutdown, got %v", err)
+	}
+}